@@ -0,0 +1,146 @@
+package spapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClientSecretRotation is the payload of an APPLICATION_OAUTH_CLIENT_NEW_SECRET
+// notification.
+type ClientSecretRotation struct {
+	ClientID                  string    `json:"clientId"`
+	NewClientSecret           string    `json:"newClientSecret"`
+	NewClientSecretExpiryTime time.Time `json:"newClientSecretExpiryTime"`
+	OldClientSecretExpiryTime time.Time `json:"oldClientSecretExpiryTime"`
+}
+
+// ClientSecretExpiry is the payload of an APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY
+// notification.
+type ClientSecretExpiry struct {
+	ClientID                 string    `json:"clientId"`
+	ClientSecretExpiryTime   time.Time `json:"clientSecretExpiryTime"`
+	ClientSecretExpiryReason string    `json:"clientSecretExpiryReason"`
+}
+
+// RotateApplicationClientSecret asks Amazon to issue a new LWA client secret.
+// The secret is not returned; it is delivered by an
+// APPLICATION_OAUTH_CLIENT_NEW_SECRET notification, see
+// HandleApplicationNotification.
+func (s *Client) RotateApplicationClientSecret(ctx context.Context) error {
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+		Path:   "/applications/2023-11-30/clientSecret",
+	}
+
+	req := Request{
+		Operation:     "applications.rotateApplicationClientSecret",
+		Method:        http.MethodPost,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Minute,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+// credentials returns the current LWA client id and secret.
+func (s *Client) credentials() (clientID, clientSecret string) {
+	s.credMu.RLock()
+	defer s.credMu.RUnlock()
+	return s.ClientID, s.ClientSecret
+}
+
+// SetClientSecret swaps the LWA client secret used for later token requests.
+func (s *Client) SetClientSecret(secret string) {
+	s.credMu.Lock()
+	defer s.credMu.Unlock()
+	s.ClientSecret = secret
+}
+
+// HandleApplicationNotification processes APPLICATION_OAUTH_CLIENT_NEW_SECRET
+// and APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY notifications. A new secret is
+// passed to OnClientSecretRotated, and the client switches to it once the hook
+// returns without error. handled is false for other notification types.
+func (s *Client) HandleApplicationNotification(ctx context.Context, body []byte) (handled bool, err error) {
+	n, err := ParseNotification(body)
+	if err != nil {
+		return false, err
+	}
+
+	switch n.NotificationType {
+	case NotificationTypeApplicationOAuthClientNewSecret, NotificationTypeApplicationOAuthClientSecretExpiry:
+		return true, s.handleApplicationNotification(ctx, n)
+	}
+	return false, nil
+}
+
+// RegisterApplicationHandlers routes the client secret notifications on d to
+// the client.
+func (s *Client) RegisterApplicationHandlers(d *Dispatcher) {
+	d.HandleFunc(NotificationTypeApplicationOAuthClientNewSecret, s.handleApplicationNotification)
+	d.HandleFunc(NotificationTypeApplicationOAuthClientSecretExpiry, s.handleApplicationNotification)
+}
+
+func (s *Client) handleApplicationNotification(ctx context.Context, n *Notification) error {
+	clientID, _ := s.credentials()
+
+	switch n.NotificationType {
+	case NotificationTypeApplicationOAuthClientNewSecret:
+		var r ClientSecretRotation
+		if err := n.Decode(&r); err != nil {
+			return err
+		}
+		if r.NewClientSecret == "" {
+			return fmt.Errorf("notification %s has no new client secret", n.NotificationType)
+		}
+		if r.ClientID != clientID {
+			return fmt.Errorf("notification is for client %s, not %s", r.ClientID, clientID)
+		}
+
+		if s.OnClientSecretRotated != nil {
+			if err := s.OnClientSecretRotated(ctx, r); err != nil {
+				return fmt.Errorf("error persisting rotated client secret: %w", err)
+			}
+		}
+		s.SetClientSecret(r.NewClientSecret)
+
+		s.logger().LogAttrs(ctx, slog.LevelInfo, "rotated LWA client secret",
+			slog.String("client_id", r.ClientID),
+			slog.Time("new_secret_expiry", r.NewClientSecretExpiryTime),
+			slog.Time("old_secret_expiry", r.OldClientSecretExpiryTime),
+		)
+		return nil
+
+	case NotificationTypeApplicationOAuthClientSecretExpiry:
+		var e ClientSecretExpiry
+		if err := n.Decode(&e); err != nil {
+			return err
+		}
+		if e.ClientID != clientID {
+			return fmt.Errorf("notification is for client %s, not %s", e.ClientID, clientID)
+		}
+
+		s.logger().LogAttrs(ctx, slog.LevelWarn, "LWA client secret expiring",
+			slog.String("client_id", e.ClientID),
+			slog.Time("expiry", e.ClientSecretExpiryTime),
+			slog.String("reason", e.ClientSecretExpiryReason),
+		)
+		if s.OnClientSecretExpiry != nil {
+			return s.OnClientSecretExpiry(ctx, e)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unexpected notification type %s", n.NotificationType)
+}
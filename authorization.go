@@ -0,0 +1,165 @@
+package spapi
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var DefaultSellerCentralURL = "https://sellercentral.amazon.com"
+
+const stateCookieName = "spapi_oauth_state"
+
+var (
+	ErrInvalidState     = errors.New("spapi: oauth state mismatch")
+	ErrMissingOAuthCode = errors.New("spapi: redirect is missing spapi_oauth_code")
+)
+
+// Authorizer implements the Seller Central website authorization workflow.
+type Authorizer struct {
+	ApplicationID string
+	ClientID      string
+	ClientSecret  string
+	RedirectURI   string
+
+	// SellerCentralURL is the Seller Central host for the seller's
+	// marketplace, see Marketplace.SellerCentralURL. Defaults to
+	// DefaultSellerCentralURL.
+	SellerCentralURL string
+	// Beta adds version=beta to the consent URL for apps in draft state.
+	Beta bool
+
+	HTTPClient *http.Client
+}
+
+type AuthorizationResult struct {
+	SellerID string
+	Token    *oauth2.Token
+}
+
+// NewState returns a random CSRF state value.
+func NewState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating oauth state: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AuthorizationURL builds the Seller Central consent URL.
+func (a *Authorizer) AuthorizationURL(state string) string {
+	base := a.SellerCentralURL
+	if base == "" {
+		base = DefaultSellerCentralURL
+	}
+
+	qs := url.Values{}
+	qs.Set("application_id", a.ApplicationID)
+	qs.Set("state", state)
+	if a.RedirectURI != "" {
+		qs.Set("redirect_uri", a.RedirectURI)
+	}
+	if a.Beta {
+		qs.Set("version", "beta")
+	}
+
+	return strings.TrimRight(base, "/") + "/apps/authorize/consent?" + qs.Encode()
+}
+
+// HandleRedirect validates the state on the redirect request and exchanges
+// the authorization code for tokens.
+func (a *Authorizer) HandleRedirect(ctx context.Context, r *http.Request, expectedState string) (*AuthorizationResult, error) {
+	qs := r.URL.Query()
+
+	state := qs.Get("state")
+	if expectedState == "" || subtle.ConstantTimeCompare([]byte(state), []byte(expectedState)) != 1 {
+		return nil, ErrInvalidState
+	}
+
+	code := qs.Get("spapi_oauth_code")
+	if code == "" {
+		return nil, ErrMissingOAuthCode
+	}
+
+	token, err := a.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthorizationResult{
+		SellerID: qs.Get("selling_partner_id"),
+		Token:    token,
+	}, nil
+}
+
+// Exchange trades an spapi_oauth_code for an access and refresh token.
+func (a *Authorizer) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	body := url.Values{}
+	body.Set("grant_type", "authorization_code")
+	body.Set("code", code)
+	body.Set("client_id", a.ClientID)
+	body.Set("client_secret", a.ClientSecret)
+	if a.RedirectURI != "" {
+		body.Set("redirect_uri", a.RedirectURI)
+	}
+
+	return requestLWAToken(ctx, a.HTTPClient, body)
+}
+
+// LoginHandler stores a new state in a cookie and redirects the seller to
+// the consent page.
+func (a *Authorizer) LoginHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state, err := NewState()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     stateCookieName,
+			Value:    state,
+			Path:     "/",
+			MaxAge:   int((10 * time.Minute).Seconds()),
+			HttpOnly: true,
+			Secure:   true,
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(w, r, a.AuthorizationURL(state), http.StatusFound)
+	})
+}
+
+// CallbackHandler checks the redirect against the state cookie set by
+// LoginHandler, exchanges the code and passes the result to onSuccess.
+// Failures are answered with 400 or 502.
+func (a *Authorizer) CallbackHandler(onSuccess func(w http.ResponseWriter, r *http.Request, res *AuthorizationResult)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(stateCookieName)
+		if err != nil {
+			http.Error(w, ErrInvalidState.Error(), http.StatusBadRequest)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: stateCookieName, Path: "/", MaxAge: -1})
+
+		res, err := a.HandleRedirect(r.Context(), r, cookie.Value)
+		if err != nil {
+			status := http.StatusBadGateway
+			if errors.Is(err, ErrInvalidState) || errors.Is(err, ErrMissingOAuthCode) {
+				status = http.StatusBadRequest
+			}
+			http.Error(w, redactString(err.Error()), status)
+			return
+		}
+
+		onSuccess(w, r, res)
+	})
+}
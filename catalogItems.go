@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -227,6 +228,10 @@ func (s *Client) paginate(ctx context.Context, qs url.Values, limit int) (*Searc
 			}
 
 			resp.Items = append(resp.Items, nextPage.Items...)
+			s.logger().LogAttrs(ctx, slog.LevelDebug, "fetched catalog items page",
+				slog.Int("page", i+1),
+				slog.Int("items", len(resp.Items)),
+			)
 			if nextPage.Pagination.NextToken == "" {
 				break
 			}
@@ -244,16 +249,15 @@ func (s *Client) searchCatalogItems(ctx context.Context, qs url.Values) (*Search
 		RawQuery: qs.Encode(),
 	}
 
-	req := request{
-		Method: http.MethodGet,
-		URL:    &u,
-		Token:  s.Token,
+	req := Request{
+		Operation:     "catalogItems.searchCatalogItems",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
 	}
 
-	res, err := s.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 1 * time.Second,
-	})
+	res, err := s.do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
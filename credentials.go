@@ -0,0 +1,102 @@
+package spapi
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type credentials struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	SellerID     string
+	Marketplace  string
+}
+
+// SharedCredentialsFile returns SPAPI_SHARED_CREDENTIALS_FILE or
+// ~/.spapi/credentials.
+func SharedCredentialsFile() (string, error) {
+	if path := os.Getenv("SPAPI_SHARED_CREDENTIALS_FILE"); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error finding home directory: %w", err)
+	}
+	return filepath.Join(home, ".spapi", "credentials"), nil
+}
+
+// loadProfile reads an INI style file in the AWS CLI format:
+//
+//	[default]
+//	client_id = amzn1.application-oa2-client.xxx
+//	client_secret = xxx
+//	refresh_token = Atzr|xxx
+//	seller_id = A1XXXXXXXX
+//	marketplace = DE
+func loadProfile(path, name string) (*credentials, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening credentials file: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		creds   credentials
+		section string
+		found   bool
+		lineNo  int
+	)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			section = strings.TrimSpace(strings.TrimPrefix(section, "profile "))
+			if section == name {
+				found = true
+			}
+			continue
+		}
+		if section != name {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("error parsing credentials file %s:%d: expected key = value", path, lineNo)
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "client_id":
+			creds.ClientID = value
+		case "client_secret":
+			creds.ClientSecret = value
+		case "refresh_token":
+			creds.RefreshToken = value
+		case "seller_id":
+			creds.SellerID = value
+		case "marketplace":
+			creds.Marketplace = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading credentials file: %w", err)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	return &creds, nil
+}
@@ -0,0 +1,268 @@
+package spapi
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact base-10 number, coef * 10^-scale. The zero value is 0.
+// Decimals are immutable; every operation returns a new value.
+//
+// Decimal holds a pointer, so == compares identity, not value: two equal
+// amounts parsed separately are never ==. The same applies to Money and any
+// struct containing a Decimal. Use Equal or Cmp instead.
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+// maxDecimalScale bounds exponents and scales. It keeps hostile input such
+// as "1e2000000000" from allocating huge powers of ten; Mul results with a
+// larger scale are rounded to it.
+const maxDecimalScale = 1000
+
+var bigTen = big.NewInt(10)
+
+// NewDecimal returns unscaled * 10^-scale. It panics if |scale| exceeds
+// maxDecimalScale.
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		panic(fmt.Sprintf("spapi: decimal scale %d out of range", scale))
+	}
+	coef := big.NewInt(unscaled)
+	if scale < 0 {
+		coef.Mul(coef, pow10(int64(-scale)))
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
+
+// ParseDecimal parses plain and exponent notation, e.g. "12.99" or "1.5E-2".
+func ParseDecimal(s string) (Decimal, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+
+	var exp int64
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil || e > maxDecimalScale || e < -maxDecimalScale {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+		}
+		exp = e
+		s = s[:i]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	digits := intPart + fracPart
+	if digits == "" || digits == "-" || digits == "+" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", orig)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: scale out of range", orig)
+	}
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+// MustDecimal is ParseDecimal that panics on error, for constants.
+func MustDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromFloat converts f using the shortest representation that
+// round-trips, so 0.1 becomes exactly 0.1.
+func DecimalFromFloat(f float64) Decimal {
+	d, err := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return Decimal{}
+	}
+	return d
+}
+
+func (d Decimal) int() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale returns d's coefficient at a larger scale. Every constructor keeps
+// scales within [0, maxDecimalScale], so the multiplier is bounded; a larger
+// step is a bug.
+func (d Decimal) rescale(scale int32) *big.Int {
+	c := new(big.Int).Set(d.int())
+	if scale > d.scale {
+		step := int64(scale) - int64(d.scale)
+		if step > maxDecimalScale {
+			panic(fmt.Sprintf("spapi: decimal rescale by %d out of range", step))
+		}
+		c.Mul(c, pow10(step))
+	}
+	return c
+}
+
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	scale := max(a.scale, b.scale)
+	return a.rescale(scale), b.rescale(scale), scale
+}
+
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return Decimal{coef: a.Add(a, b), scale: scale}
+}
+
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return Decimal{coef: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns d * o. Products with a scale above maxDecimalScale are rounded
+// to it.
+func (d Decimal) Mul(o Decimal) Decimal {
+	p := Decimal{coef: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+	if p.scale > maxDecimalScale {
+		return p.Round(maxDecimalScale)
+	}
+	return p
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.int()), scale: d.scale}
+}
+
+// Cmp returns -1, 0 or 1 as d is less than, equal to or greater than o.
+func (d Decimal) Cmp(o Decimal) int {
+	a, b, _ := align(d, o)
+	return a.Cmp(b)
+}
+
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Round rounds half away from zero to places decimal digits. places is
+// clamped to ±maxDecimalScale.
+func (d Decimal) Round(places int32) Decimal {
+	places = min(max(places, -maxDecimalScale), maxDecimalScale)
+	if d.scale <= places {
+		return Decimal{coef: d.rescale(places), scale: places}
+	}
+
+	div := pow10(int64(d.scale) - int64(places))
+	q, r := new(big.Int).QuoRem(d.int(), div, new(big.Int))
+
+	// compare 2*|r| with div to decide rounding
+	r.Abs(r).Lsh(r, 1)
+	if r.Cmp(div) >= 0 {
+		if d.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	if places < 0 {
+		// keep scales non-negative, as ParseDecimal does
+		return Decimal{coef: q.Mul(q, pow10(int64(-places))), scale: 0}
+	}
+	return Decimal{coef: q, scale: places}
+}
+
+// Float64 returns the nearest float64, for display or statistics only.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns d in plain notation without trailing zeros beyond its scale.
+func (d Decimal) String() string {
+	return d.format(d.scale)
+}
+
+// StringFixed returns d rounded to exactly places decimal digits.
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places).format(places)
+}
+
+func (d Decimal) format(scale int32) string {
+	if scale <= 0 {
+		return d.int().String()
+	}
+
+	s := new(big.Int).Abs(d.int()).String()
+	if len(s) <= int(scale) {
+		s = strings.Repeat("0", int(scale)-len(s)+1) + s
+	}
+	out := s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	if d.Sign() < 0 {
+		out = "-" + out
+	}
+	return out
+}
+
+// MarshalJSON writes d as a bare JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON accepts JSON numbers and numeric strings.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		*d = Decimal{}
+		return nil
+	}
+	if len(b) > 1 && b[0] == '"' {
+		s, err := strconv.Unquote(string(b))
+		if err != nil {
+			return err
+		}
+		if s == "" {
+			*d = Decimal{}
+			return nil
+		}
+		b = []byte(s)
+	}
+
+	v, err := ParseDecimal(string(b))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
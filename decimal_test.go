@@ -0,0 +1,172 @@
+package spapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "12.99", want: "12.99"},
+		{in: "-12.300", want: "-12.300"},
+		{in: "+3.5", want: "3.5"},
+		{in: ".5", want: "0.5"},
+		{in: "-.5", want: "-0.5"},
+		{in: "-0.05", want: "-0.05"},
+		{in: " 7 ", want: "7"},
+		{in: "1.5E-2", want: "0.015"},
+		{in: "1e3", want: "1000"},
+		{in: "-2.5e1", want: "-25"},
+		{in: "1e1000", want: "1" + strings.Repeat("0", 1000)},
+		{in: "1e-1000", want: "0." + strings.Repeat("0", 999) + "1"},
+		{in: "0." + strings.Repeat("0", 999) + "1", want: "0." + strings.Repeat("0", 999) + "1"},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "1e", wantErr: true},
+		{in: "1e1001", wantErr: true},
+		{in: "1e-1001", wantErr: true},
+		{in: "1e2000000000", wantErr: true},
+		{in: "0." + strings.Repeat("0", 1000) + "1", wantErr: true},
+		{in: "1.5e-1000", wantErr: true},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseDecimal(%q) = %s, want error", tt.in, d)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("ParseDecimal(%q).String() = %q, want %q", tt.in, got, tt.want)
+		}
+
+		// String output parses back to an equal value
+		back, err := ParseDecimal(d.String())
+		if err != nil || !back.Equal(d) {
+			t.Errorf("ParseDecimal(%q) does not round-trip: %s, %v", tt.in, back, err)
+		}
+	}
+}
+
+func TestDecimalStringFixed(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int32
+		want   string
+	}{
+		{"1.005", 2, "1.01"},
+		{"-1.005", 2, "-1.01"},
+		{"1.004", 2, "1.00"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"0.1", 3, "0.100"},
+		{"-0.001", 2, "0.00"},
+		{"1234.5", -2, "1200"},
+		{"12", 2, "12.00"},
+	}
+	for _, tt := range tests {
+		if got := MustDecimal(tt.in).StringFixed(tt.places); got != tt.want {
+			t.Errorf("%s.StringFixed(%d) = %q, want %q", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	tests := []struct {
+		a, b      string
+		sum, diff string
+		cmp       int
+	}{
+		{"0.1", "0.2", "0.3", "-0.1", -1},
+		{"-5", "3.25", "-1.75", "-8.25", -1},
+		{"1.10", "1.1", "2.20", "0.00", 0},
+		{"-0.5", "-0.75", "-1.25", "0.25", 1},
+		{"100", "0.01", "100.01", "99.99", 1},
+	}
+	for _, tt := range tests {
+		a, b := MustDecimal(tt.a), MustDecimal(tt.b)
+		if got := a.Add(b).String(); got != tt.sum {
+			t.Errorf("%s + %s = %s, want %s", tt.a, tt.b, got, tt.sum)
+		}
+		if got := a.Sub(b).String(); got != tt.diff {
+			t.Errorf("%s - %s = %s, want %s", tt.a, tt.b, got, tt.diff)
+		}
+		if got := a.Cmp(b); got != tt.cmp {
+			t.Errorf("%s.Cmp(%s) = %d, want %d", tt.a, tt.b, got, tt.cmp)
+		}
+	}
+}
+
+func TestDecimalScaleLimit(t *testing.T) {
+	// products above maxDecimalScale are rounded half away from zero
+	p := NewDecimal(5, maxDecimalScale).Mul(MustDecimal("0.1"))
+	if want := NewDecimal(1, maxDecimalScale); !p.Equal(want) {
+		t.Errorf("Mul rounded to %s, want %s", p, want)
+	}
+	if p := NewDecimal(4, maxDecimalScale).Mul(MustDecimal("-0.1")); !p.IsZero() {
+		t.Errorf("Mul rounded to %s, want 0", p)
+	}
+
+	if got := MustDecimal("1.5").Round(maxDecimalScale + 5).String(); len(got) != 2+maxDecimalScale {
+		t.Errorf("Round beyond maxDecimalScale kept %d digits", len(got)-2)
+	}
+
+	for _, scale := range []int32{maxDecimalScale + 1, -maxDecimalScale - 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewDecimal(1, %d) did not panic", scale)
+				}
+			}()
+			NewDecimal(1, scale)
+		}()
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`12.50`, "12.50"},
+		{`"12.50"`, "12.50"},
+		{`-3`, "-3"},
+		{`"1e2"`, "100"},
+		{`""`, "0"},
+		{`null`, "0"},
+	}
+	for _, tt := range tests {
+		var d Decimal
+		if err := json.Unmarshal([]byte(tt.in), &d); err != nil {
+			t.Errorf("Unmarshal(%s) error: %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("Unmarshal(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{`"abc"`, `true`, `"1e5000"`} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(in), &d); err == nil {
+			t.Errorf("Unmarshal(%s) = %s, want error", in, d)
+		}
+	}
+
+	b, err := json.Marshal(MustDecimal("-0.10"))
+	if err != nil || string(b) != "-0.10" {
+		t.Errorf("Marshal(-0.10) = %s, %v", b, err)
+	}
+}
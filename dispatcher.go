@@ -0,0 +1,406 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+type NotificationMetadata struct {
+	ApplicationID  string    `json:"ApplicationId"`
+	SubscriptionID string    `json:"SubscriptionId"`
+	PublishTime    time.Time `json:"PublishTime"`
+	NotificationID string    `json:"NotificationId"`
+}
+
+// Notification is the SP-API notification envelope. Keys are matched case
+// insensitively, so both the PascalCase and camelCase envelopes decode.
+type Notification struct {
+	NotificationVersion  string               `json:"NotificationVersion"`
+	NotificationType     NotificationType     `json:"NotificationType"`
+	PayloadVersion       string               `json:"PayloadVersion"`
+	EventTime            time.Time            `json:"EventTime"`
+	Payload              json.RawMessage      `json:"Payload"`
+	NotificationMetadata NotificationMetadata `json:"NotificationMetadata"`
+}
+
+// ParseNotification decodes a raw SQS message body or EventBridge event.
+func ParseNotification(body []byte) (*Notification, error) {
+	var event struct {
+		DetailType string          `json:"detail-type"`
+		Detail     json.RawMessage `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("error decoding notification: %w", err)
+	}
+	if len(event.Detail) > 0 {
+		body = event.Detail
+	}
+
+	var n Notification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, fmt.Errorf("error decoding notification: %w", err)
+	}
+	if n.NotificationType == "" {
+		return nil, fmt.Errorf("error decoding notification: missing notificationType")
+	}
+	return &n, nil
+}
+
+// Decode unmarshals the payload into v, unwrapping the per-type wrapper
+// object (e.g. OrderChangeNotification) when the type has one.
+func (n *Notification) Decode(v any) error {
+	payload := n.Payload
+	if key, ok := payloadKeys[n.NotificationType]; ok {
+		var wrapper map[string]json.RawMessage
+		if err := json.Unmarshal(payload, &wrapper); err != nil {
+			return fmt.Errorf("error decoding %s payload: %w", n.NotificationType, err)
+		}
+		for k, raw := range wrapper {
+			if strings.EqualFold(k, key) {
+				payload = raw
+				break
+			}
+		}
+	}
+
+	if err := json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("error decoding %s payload: %w", n.NotificationType, err)
+	}
+	return nil
+}
+
+type Message struct {
+	ID            string
+	ReceiptHandle string
+	Body          []byte
+}
+
+// MessageSource delivers raw notification messages, e.g. from an SQS queue.
+// Receive should block until messages arrive or ctx is done. Messages that
+// are not deleted are expected to be redelivered.
+type MessageSource interface {
+	Receive(ctx context.Context) ([]Message, error)
+	Delete(ctx context.Context, m Message) error
+}
+
+// Deduper remembers handled notification IDs. TryClaim must be atomic: it
+// reports false when the ID was already handled or is claimed by another
+// in-flight delivery. A claim ends with Mark when the handler succeeds or
+// Release when it fails.
+type Deduper interface {
+	TryClaim(ctx context.Context, notificationID string) (bool, error)
+	Mark(ctx context.Context, notificationID string) error
+	Release(ctx context.Context, notificationID string) error
+}
+
+// MemoryDeduper keeps the most recent Size notification IDs in memory.
+type MemoryDeduper struct {
+	Size int
+
+	mu      sync.Mutex
+	seen    map[string]struct{}
+	claimed map[string]struct{}
+	order   []string
+}
+
+func NewMemoryDeduper(size int) *MemoryDeduper {
+	return &MemoryDeduper{Size: size}
+}
+
+func (d *MemoryDeduper) TryClaim(ctx context.Context, id string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.seen[id]; ok {
+		return false, nil
+	}
+	if _, ok := d.claimed[id]; ok {
+		return false, nil
+	}
+	if d.claimed == nil {
+		d.claimed = map[string]struct{}{}
+	}
+	d.claimed[id] = struct{}{}
+	return true, nil
+}
+
+func (d *MemoryDeduper) Release(ctx context.Context, id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.claimed, id)
+	return nil
+}
+
+func (d *MemoryDeduper) Mark(ctx context.Context, id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.claimed, id)
+	if d.seen == nil {
+		d.seen = map[string]struct{}{}
+	}
+	if _, ok := d.seen[id]; ok {
+		return nil
+	}
+	d.seen[id] = struct{}{}
+	d.order = append(d.order, id)
+
+	if d.Size > 0 && len(d.order) > d.Size {
+		delete(d.seen, d.order[0])
+		d.order = d.order[1:]
+	}
+	return nil
+}
+
+type NotificationHandler func(ctx context.Context, n *Notification) error
+
+// ErrDuplicateNotification is returned by Dispatch for notifications that
+// were already handled or are being handled by another delivery.
+var ErrDuplicateNotification = errors.New("spapi: duplicate notification")
+
+// Dispatcher routes notifications to handlers by type and drops duplicates
+// by NotificationId.
+type Dispatcher struct {
+	Deduper  Deduper
+	Logger   *slog.Logger
+	Fallback NotificationHandler
+
+	mu       sync.RWMutex
+	handlers map[NotificationType]NotificationHandler
+}
+
+// NewDispatcher returns a Dispatcher that remembers the last 10000
+// notification IDs.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		Deduper:  NewMemoryDeduper(10000),
+		handlers: map[NotificationType]NotificationHandler{},
+	}
+}
+
+// HandleFunc registers fn for notificationType, replacing any previous
+// handler.
+func (d *Dispatcher) HandleFunc(notificationType NotificationType, fn NotificationHandler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.handlers == nil {
+		d.handlers = map[NotificationType]NotificationHandler{}
+	}
+	d.handlers[notificationType] = fn
+}
+
+// On registers a typed handler; the payload is decoded into T, e.g.
+//
+//	spapi.On(d, spapi.NotificationTypeOrderChange, func(ctx context.Context, n *spapi.Notification, p *spapi.OrderChangeNotification) error {
+//		...
+//	})
+func On[T any](d *Dispatcher, notificationType NotificationType, fn func(ctx context.Context, n *Notification, payload *T) error) {
+	d.HandleFunc(notificationType, func(ctx context.Context, n *Notification) error {
+		var payload T
+		if err := n.Decode(&payload); err != nil {
+			return err
+		}
+		return fn(ctx, n, &payload)
+	})
+}
+
+func (d *Dispatcher) logger() *slog.Logger {
+	if d.Logger == nil {
+		return slog.New(discardHandler{})
+	}
+	return slog.New(redactHandler{next: d.Logger.Handler()})
+}
+
+// Dispatch parses body and calls the matching handler. Notifications without
+// a handler or fallback are ignored. The notification ID is claimed before
+// the handler runs, so concurrent copies of the same notification are
+// dropped; it is marked as seen once the handler succeeds and released when
+// it fails, so failed messages can be retried.
+func (d *Dispatcher) Dispatch(ctx context.Context, body []byte) error {
+	n, err := ParseNotification(body)
+	if err != nil {
+		return err
+	}
+
+	id := n.NotificationMetadata.NotificationID
+	dedupe := id != "" && d.Deduper != nil
+	if dedupe {
+		claimed, err := d.Deduper.TryClaim(ctx, id)
+		if err != nil {
+			return fmt.Errorf("error claiming notification %s: %w", id, err)
+		}
+		if !claimed {
+			return ErrDuplicateNotification
+		}
+	}
+
+	d.mu.RLock()
+	h, ok := d.handlers[n.NotificationType]
+	d.mu.RUnlock()
+	if !ok {
+		h = d.Fallback
+	}
+	if h == nil {
+		d.logger().LogAttrs(ctx, slog.LevelDebug, "no handler for notification",
+			slog.String("notification_type", string(n.NotificationType)),
+			slog.String("notification_id", id),
+		)
+		if dedupe {
+			return d.release(ctx, id, nil)
+		}
+		return nil
+	}
+
+	if err := h(ctx, n); err != nil {
+		if dedupe {
+			return d.release(ctx, id, err)
+		}
+		return err
+	}
+
+	if dedupe {
+		if err := d.Deduper.Mark(ctx, id); err != nil {
+			return d.release(ctx, id, fmt.Errorf("error marking notification %s: %w", id, err))
+		}
+	}
+	return nil
+}
+
+// release drops the claim on id and returns err, joined with any release
+// error.
+func (d *Dispatcher) release(ctx context.Context, id string, err error) error {
+	if rerr := d.Deduper.Release(ctx, id); rerr != nil {
+		return errors.Join(err, fmt.Errorf("error releasing notification %s: %w", id, rerr))
+	}
+	return err
+}
+
+// Run receives from src until ctx is done. Handled and duplicate messages are
+// deleted; failed ones are left for redelivery.
+func (d *Dispatcher) Run(ctx context.Context, src MessageSource) error {
+	for {
+		msgs, err := src.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("error receiving notifications: %w", err)
+		}
+
+		for _, m := range msgs {
+			err := d.Dispatch(ctx, m.Body)
+			if err != nil && !errors.Is(err, ErrDuplicateNotification) {
+				d.logger().LogAttrs(ctx, slog.LevelError, "error handling notification",
+					slog.String("message_id", m.ID),
+					slog.Any("error", err),
+				)
+				continue
+			}
+
+			if err := src.Delete(ctx, m); err != nil {
+				d.logger().LogAttrs(ctx, slog.LevelWarn, "error deleting notification message",
+					slog.String("message_id", m.ID),
+					slog.Any("error", err),
+				)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// MemoryQueue is an in-memory MessageSource, e.g. a stand-in for SQS in
+// tests. Received messages that are not deleted can be put back with
+// Redeliver.
+type MemoryQueue struct {
+	mu       sync.Mutex
+	pending  []Message
+	inflight map[string]Message
+	notify   chan struct{}
+	nextID   int
+}
+
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{
+		inflight: map[string]Message{},
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// Send enqueues body and returns the message ID.
+func (q *MemoryQueue) Send(body []byte) string {
+	q.mu.Lock()
+	q.nextID++
+	id := fmt.Sprintf("msg-%d", q.nextID)
+	q.pending = append(q.pending, Message{ID: id, ReceiptHandle: id, Body: body})
+	q.mu.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return id
+}
+
+func (q *MemoryQueue) Receive(ctx context.Context) ([]Message, error) {
+	for {
+		q.mu.Lock()
+		if len(q.pending) > 0 {
+			msgs := q.pending
+			q.pending = nil
+			for _, m := range msgs {
+				q.inflight[m.ReceiptHandle] = m
+			}
+			q.mu.Unlock()
+			return msgs, nil
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-q.notify:
+		}
+	}
+}
+
+func (q *MemoryQueue) Delete(ctx context.Context, m Message) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.inflight, m.ReceiptHandle)
+	return nil
+}
+
+// Redeliver moves every received but undeleted message back to the queue.
+func (q *MemoryQueue) Redeliver() {
+	q.mu.Lock()
+	for _, m := range q.inflight {
+		q.pending = append(q.pending, m)
+	}
+	q.inflight = map[string]Message{}
+	q.mu.Unlock()
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Len returns the number of pending and in-flight messages.
+func (q *MemoryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.pending) + len(q.inflight)
+}
@@ -0,0 +1,337 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type EasyShipDimensionUnit string
+
+var (
+	EasyShipDimensionUnitCentimeters EasyShipDimensionUnit = "cm"
+)
+
+type EasyShipDimensions struct {
+	Length     float64               `json:"length"`
+	Width      float64               `json:"width"`
+	Height     float64               `json:"height"`
+	Unit       EasyShipDimensionUnit `json:"unit"`
+	Identifier string                `json:"identifier,omitempty"`
+}
+
+type EasyShipWeightUnit string
+
+var (
+	EasyShipWeightUnitGrams EasyShipWeightUnit = "grams"
+	EasyShipWeightUnitG     EasyShipWeightUnit = "g"
+)
+
+type EasyShipWeight struct {
+	Value float64            `json:"value"`
+	Unit  EasyShipWeightUnit `json:"unit"`
+}
+
+type HandoverMethod string
+
+var (
+	HandoverMethodPickup  HandoverMethod = "Pickup"
+	HandoverMethodDropoff HandoverMethod = "Dropoff"
+)
+
+type TimeSlot struct {
+	SlotID         string         `json:"slotId"`
+	StartTime      *time.Time     `json:"startTime,omitempty"`
+	EndTime        *time.Time     `json:"endTime,omitempty"`
+	HandoverMethod HandoverMethod `json:"handoverMethod,omitempty"`
+}
+
+// SelectTimeSlot returns the earliest slot that starts at or after from and
+// ends by to, both measured from midnight in the marketplace's local time,
+// e.g. 10*time.Hour and 18*time.Hour for a 10:00-18:00 window in India. It
+// returns nil when no slot fits. The zone comes from Marketplace.Location,
+// so binaries without system zoneinfo should import time/tzdata.
+func (s *Client) SelectTimeSlot(slots []TimeSlot, from, to time.Duration) *TimeSlot {
+	loc := s.Marketplace.Location()
+	sinceMidnight := func(t time.Time) time.Duration {
+		t = t.In(loc)
+		midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		return t.Sub(midnight)
+	}
+
+	var best *TimeSlot
+	for i := range slots {
+		slot := &slots[i]
+		if slot.StartTime == nil || slot.EndTime == nil {
+			continue
+		}
+		start := sinceMidnight(*slot.StartTime)
+		end := start + slot.EndTime.Sub(*slot.StartTime)
+		if start < from || end > to {
+			continue
+		}
+		if best == nil || slot.StartTime.Before(*best.StartTime) {
+			best = slot
+		}
+	}
+	return best
+}
+
+type EasyShipPackageItem struct {
+	OrderItemID            string   `json:"orderItemId"`
+	OrderItemSerialNumbers []string `json:"orderItemSerialNumbers,omitempty"`
+}
+
+type ScheduledPackageID struct {
+	AmazonOrderID string `json:"amazonOrderId"`
+	PackageID     string `json:"packageId,omitempty"`
+}
+
+type EasyShipPackageStatus string
+
+var (
+	EasyShipPackageStatusReadyForPickup   EasyShipPackageStatus = "ReadyForPickup"
+	EasyShipPackageStatusPickedUp         EasyShipPackageStatus = "PickedUp"
+	EasyShipPackageStatusAtOriginFC       EasyShipPackageStatus = "AtOriginFC"
+	EasyShipPackageStatusAtDestinationFC  EasyShipPackageStatus = "AtDestinationFC"
+	EasyShipPackageStatusDelivered        EasyShipPackageStatus = "Delivered"
+	EasyShipPackageStatusRejected         EasyShipPackageStatus = "Rejected"
+	EasyShipPackageStatusUndeliverable    EasyShipPackageStatus = "Undeliverable"
+	EasyShipPackageStatusReturnedToSeller EasyShipPackageStatus = "ReturnedToSeller"
+	EasyShipPackageStatusLostInTransit    EasyShipPackageStatus = "LostInTransit"
+	EasyShipPackageStatusLabelCanceled    EasyShipPackageStatus = "LabelCanceled"
+	EasyShipPackageStatusDamagedInTransit EasyShipPackageStatus = "DamagedInTransit"
+	EasyShipPackageStatusOutForDelivery   EasyShipPackageStatus = "OutForDelivery"
+)
+
+type EasyShipPackage struct {
+	ScheduledPackageID ScheduledPackageID    `json:"scheduledPackageId"`
+	PackageDimensions  EasyShipDimensions    `json:"packageDimensions"`
+	PackageWeight      EasyShipWeight        `json:"packageWeight"`
+	PackageItems       []EasyShipPackageItem `json:"packageItems"`
+	PackageTimeSlot    TimeSlot              `json:"packageTimeSlot"`
+	PackageIdentifier  string                `json:"packageIdentifier"`
+	Invoice            *struct {
+		InvoiceNumber string     `json:"invoiceNumber"`
+		InvoiceDate   *time.Time `json:"invoiceDate"`
+	} `json:"invoice"`
+	PackageStatus   EasyShipPackageStatus `json:"packageStatus"`
+	TrackingDetails *struct {
+		TrackingID string `json:"trackingId"`
+	} `json:"trackingDetails"`
+}
+
+// EasyShipPackageDetails describes a package to schedule. Only the SlotID of
+// PackageTimeSlot is sent.
+type EasyShipPackageDetails struct {
+	PackageItems      []EasyShipPackageItem `json:"packageItems,omitempty"`
+	PackageTimeSlot   TimeSlot              `json:"packageTimeSlot"`
+	PackageIdentifier string                `json:"packageIdentifier,omitempty"`
+}
+
+func (d EasyShipPackageDetails) MarshalJSON() ([]byte, error) {
+	type alias EasyShipPackageDetails
+	d.PackageTimeSlot = TimeSlot{SlotID: d.PackageTimeSlot.SlotID}
+	return json.Marshal(alias(d))
+}
+
+// easyShipRequest calls the Easy Ship API, which is only available in the
+// IN, EG and SG marketplaces.
+func (s *Client) easyShipRequest(ctx context.Context, operation, method, path string, qs url.Values, body, out any) error {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		Path:     "/easyShip/2022-03-23" + path,
+		RawQuery: qs.Encode(),
+	}
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		req.Body = b
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// ListHandoverSlots returns the pickup or drop-off slots for a package of
+// orderID.
+func (s *Client) ListHandoverSlots(ctx context.Context, orderID string, dimensions EasyShipDimensions, weight EasyShipWeight) ([]TimeSlot, error) {
+	body := struct {
+		MarketplaceID     string             `json:"marketplaceId"`
+		AmazonOrderID     string             `json:"amazonOrderId"`
+		PackageDimensions EasyShipDimensions `json:"packageDimensions"`
+		PackageWeight     EasyShipWeight     `json:"packageWeight"`
+	}{
+		MarketplaceID:     s.Marketplace.ID,
+		AmazonOrderID:     orderID,
+		PackageDimensions: dimensions,
+		PackageWeight:     weight,
+	}
+
+	var resp struct {
+		TimeSlots []TimeSlot `json:"timeSlots"`
+	}
+	if err := s.easyShipRequest(ctx, "easyShip.listHandoverSlots", http.MethodPost, "/timeSlot", nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.TimeSlots, nil
+}
+
+func (s *Client) GetScheduledPackage(ctx context.Context, orderID string) (*EasyShipPackage, error) {
+	qs := url.Values{}
+	qs.Set("amazonOrderId", orderID)
+	qs.Set("marketplaceId", s.Marketplace.ID)
+
+	var resp EasyShipPackage
+	if err := s.easyShipRequest(ctx, "easyShip.getScheduledPackage", http.MethodGet, "/package", qs, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) CreateScheduledPackage(ctx context.Context, orderID string, details EasyShipPackageDetails) (*EasyShipPackage, error) {
+	body := struct {
+		AmazonOrderID  string                 `json:"amazonOrderId"`
+		MarketplaceID  string                 `json:"marketplaceId"`
+		PackageDetails EasyShipPackageDetails `json:"packageDetails"`
+	}{
+		AmazonOrderID:  orderID,
+		MarketplaceID:  s.Marketplace.ID,
+		PackageDetails: details,
+	}
+
+	var resp EasyShipPackage
+	if err := s.easyShipRequest(ctx, "easyShip.createScheduledPackage", http.MethodPost, "/package", nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+type UpdatePackageDetails struct {
+	ScheduledPackageID ScheduledPackageID
+	SlotID             string
+}
+
+// UpdateScheduledPackages moves packages to new handover slots.
+func (s *Client) UpdateScheduledPackages(ctx context.Context, updates []UpdatePackageDetails) ([]EasyShipPackage, error) {
+	type update struct {
+		ScheduledPackageID ScheduledPackageID `json:"scheduledPackageId"`
+		PackageTimeSlot    struct {
+			SlotID string `json:"slotId"`
+		} `json:"packageTimeSlot"`
+	}
+
+	list := make([]update, len(updates))
+	for i, u := range updates {
+		list[i].ScheduledPackageID = u.ScheduledPackageID
+		list[i].PackageTimeSlot.SlotID = u.SlotID
+	}
+	body := struct {
+		MarketplaceID            string   `json:"marketplaceId"`
+		UpdatePackageDetailsList []update `json:"updatePackageDetailsList"`
+	}{MarketplaceID: s.Marketplace.ID, UpdatePackageDetailsList: list}
+
+	var resp struct {
+		Packages []EasyShipPackage `json:"packages"`
+	}
+	if err := s.easyShipRequest(ctx, "easyShip.updateScheduledPackages", http.MethodPatch, "/package", nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Packages, nil
+}
+
+type OrderScheduleDetails struct {
+	AmazonOrderID  string                  `json:"amazonOrderId"`
+	PackageDetails *EasyShipPackageDetails `json:"packageDetails,omitempty"`
+}
+
+type RejectedOrder struct {
+	AmazonOrderID string `json:"amazonOrderId"`
+	Error         *struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type ScheduledPackagesBulk struct {
+	ScheduledPackages []EasyShipPackage `json:"scheduledPackages"`
+	RejectedOrders    []RejectedOrder   `json:"rejectedOrders"`
+	// PrintableDocumentsURL points to a ZIP of the labels and the other
+	// documents enabled for the marketplace.
+	PrintableDocumentsURL string `json:"printableDocumentsUrl"`
+}
+
+// CreateScheduledPackageBulk schedules up to 100 orders at once. labelFormat
+// is PDF or ZPL.
+func (s *Client) CreateScheduledPackageBulk(ctx context.Context, orders []OrderScheduleDetails, labelFormat LabelFormat) (*ScheduledPackagesBulk, error) {
+	body := struct {
+		MarketplaceID            string                 `json:"marketplaceId"`
+		OrderScheduleDetailsList []OrderScheduleDetails `json:"orderScheduleDetailsList"`
+		LabelFormat              LabelFormat            `json:"labelFormat"`
+	}{
+		MarketplaceID:            s.Marketplace.ID,
+		OrderScheduleDetailsList: orders,
+		LabelFormat:              labelFormat,
+	}
+
+	var resp ScheduledPackagesBulk
+	if err := s.easyShipRequest(ctx, "easyShip.createScheduledPackageBulk", http.MethodPost, "/packages/bulk", nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DownloadPrintableDocuments fetches the ZIP behind
+// bulk.PrintableDocumentsURL.
+func (s *Client) DownloadPrintableDocuments(ctx context.Context, bulk *ScheduledPackagesBulk) ([]byte, error) {
+	if bulk.PrintableDocumentsURL == "" {
+		return nil, fmt.Errorf("spapi: no printable documents for this batch")
+	}
+	return s.download(ctx, bulk.PrintableDocumentsURL)
+}
+
+// GetEasyShipDocuments requests the GET_EASYSHIP_DOCUMENTS report for a
+// scheduled order and returns the document with its invoice, shipping label
+// and warranty. It polls the report every interval.
+func (s *Client) GetEasyShipDocuments(ctx context.Context, orderID string, interval time.Duration) ([]byte, error) {
+	reportID, err := s.CreateReport(ctx, CreateReportRequest{
+		ReportType:    "GET_EASYSHIP_DOCUMENTS",
+		ReportOptions: map[string]string{"amazonOrderId": orderID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.WaitForReport(ctx, reportID, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := s.GetReportDocument(ctx, report.ReportDocumentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.DownloadReportDocument(ctx, doc)
+}
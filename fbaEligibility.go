@@ -0,0 +1,211 @@
+package spapi
+
+import (
+	"context"
+	"sync"
+)
+
+// IneligibilityReason is a code from the FBA inbound eligibility API, e.g.
+// FBA_INB_0004.
+type IneligibilityReason string
+
+var ineligibilityDescriptions = map[IneligibilityReason]string{
+	"FBA_INB_0004":           "Missing package dimensions. Dimensions must be provided for the manufacturer's original packaging.",
+	"FBA_INB_0006":           "The SKU for this product is unknown or cannot be found.",
+	"FBA_INB_0007":           "Product under dangerous goods (hazmat) review. The listing needs more detail to complete the review.",
+	"FBA_INB_0008":           "Product under dangerous goods (hazmat) review. Detailed battery information is required.",
+	"FBA_INB_0009":           "Product under dangerous goods (hazmat) review. A safety data sheet (SDS) is required.",
+	"FBA_INB_0010":           "Ineligible for inbound. The product is regulated as an unfulfillable dangerous good.",
+	"FBA_INB_0011":           "Ineligible for inbound. The product is regulated as an unfulfillable dangerous good.",
+	"FBA_INB_0012":           "Ineligible for inbound. The product is regulated as a fully regulated dangerous good.",
+	"FBA_INB_0013":           "Ineligible for inbound. The product is regulated as a fully regulated dangerous good.",
+	"FBA_INB_0014":           "Ineligible for inbound. The product is regulated as a fully regulated dangerous good.",
+	"FBA_INB_0015":           "Ineligible for inbound. The product is regulated as a fully regulated dangerous good.",
+	"FBA_INB_0016":           "Ineligible for inbound. The product is regulated as a fully regulated dangerous good.",
+	"FBA_INB_0017":           "This product does not exist in the destination marketplace catalog.",
+	"FBA_INB_0018":           "Product missing category. A category must be specified before it can be sent to Amazon.",
+	"FBA_INB_0019":           "Product missing title. A title is required before it can be sent to Amazon.",
+	"FBA_INB_0034":           "Product cannot be sent to Amazon because it is restricted.",
+	"FBA_INB_0035":           "Expiration-dated or lot-controlled product needs to be labeled by Amazon.",
+	"FBA_INB_0036":           "Expiration-dated or lot-controlled product needs to be commingled.",
+	"FBA_INB_0037":           "This product is not eligible to be shipped to Amazon fulfillment centers.",
+	"FBA_INB_0038":           "Parent ASIN cannot be fulfilled by Amazon. List the child ASIN instead.",
+	"FBA_INB_0050":           "No fulfillment center in the destination country can receive this product.",
+	"FBA_INB_0051":           "This product has been blocked by FBA.",
+	"FBA_INB_0053":           "Product is not eligible in the destination marketplace.",
+	"FBA_INB_0055":           "Product is unfulfillable due to media region restrictions.",
+	"FBA_INB_0056":           "Product is ineligible for inbound. Used non-media goods cannot be sold as used.",
+	"FBA_INB_0059":           "Unknown exception. This product must be reviewed by Amazon.",
+	"FBA_INB_0065":           "Product cannot be commingled.",
+	"FBA_INB_0066":           "Unknown exception. This product must be reviewed by Amazon.",
+	"FBA_INB_0067":           "Product is ineligible for freight shipping.",
+	"UNKNOWN_INB_ERROR_CODE": "Unknown ineligibility reason.",
+}
+
+// Description returns a human readable explanation of r.
+func (r IneligibilityReason) Description() string {
+	if d, ok := ineligibilityDescriptions[r]; ok {
+		return d
+	}
+	return "Unknown ineligibility reason " + string(r) + "."
+}
+
+type ProgramEligibility struct {
+	Eligible bool
+	Reasons  []IneligibilityReason
+	Err      error
+}
+
+// FBAEligibilityReport merges eligibility for each program and the prep
+// requirements of one ASIN. InvalidReason is set when the prep instructions
+// call rejected the ASIN, e.g. DoesNotExist.
+type FBAEligibilityReport struct {
+	ASIN          string
+	Programs      map[FulfillmentInboundProgram]ProgramEligibility
+	Prep          *PrepInstruction
+	InvalidReason string
+	PrepErr       error
+}
+
+// Eligible reports whether the ASIN is valid and eligible for every checked
+// program.
+func (r *FBAEligibilityReport) Eligible() bool {
+	if r.InvalidReason != "" || r.PrepErr != nil {
+		return false
+	}
+	for _, p := range r.Programs {
+		if !p.Eligible || p.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+type FBAEligibilityOptions struct {
+	// Programs defaults to INBOUND and COMMINGLING.
+	Programs []FulfillmentInboundProgram
+	// ShipToCountryCode defaults to the client's marketplace country.
+	ShipToCountryCode string
+	// Concurrency bounds in-flight requests; it defaults to 4. Requests
+	// still wait on the client's rate limiter.
+	Concurrency int
+}
+
+const maxPrepInstructionASINs = 50
+
+// CheckFBAEligibility checks eligibility and prep requirements for asins.
+// Per-ASIN failures are recorded in the report; only ctx errors abort the
+// batch. Reports are returned in the order of asins.
+func (s *Client) CheckFBAEligibility(ctx context.Context, asins []string, opts *FBAEligibilityOptions) ([]FBAEligibilityReport, error) {
+	if opts == nil {
+		opts = &FBAEligibilityOptions{}
+	}
+	programs := opts.Programs
+	if len(programs) == 0 {
+		programs = []FulfillmentInboundProgram{FulfillmentInboundProgramInbound, FulfillmentInboundProgramCommingle}
+	}
+	shipTo := opts.ShipToCountryCode
+	if shipTo == "" {
+		shipTo = s.Marketplace.CountryCode
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	reports := make([]FBAEligibilityReport, len(asins))
+	index := make(map[string][]int, len(asins))
+	for i, asin := range asins {
+		reports[i] = FBAEligibilityReport{
+			ASIN:     asin,
+			Programs: make(map[FulfillmentInboundProgram]ProgramEligibility, len(programs)),
+		}
+		index[asin] = append(index[asin], i)
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	run := func(fn func()) {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn()
+		}()
+	}
+
+	for i := 0; i < len(asins); i += maxPrepInstructionASINs {
+		batch := asins[i:min(i+maxPrepInstructionASINs, len(asins))]
+		run(func() {
+			resp, err := s.GetItemPrepInstructions(ctx, shipTo, batch)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				for _, asin := range batch {
+					for _, j := range index[asin] {
+						reports[j].PrepErr = err
+					}
+				}
+				return
+			}
+			for _, p := range resp.ASINPrepInstructionsList {
+				for _, j := range index[p.ASIN] {
+					reports[j].Prep = &PrepInstruction{
+						PrepInstructionList: p.PrepInstructionList,
+						BarcodeInstruction:  p.BarcodeInstruction,
+						PrepGuidance:        p.PrepGuidance,
+					}
+				}
+			}
+			for _, inv := range resp.InvalidASINList {
+				for _, j := range index[inv.ASIN] {
+					reports[j].InvalidReason = inv.ErrorReason
+				}
+			}
+		})
+	}
+
+	checked := make(map[string]bool, len(index))
+	for _, asin := range asins {
+		if checked[asin] {
+			continue
+		}
+		checked[asin] = true
+
+		for _, program := range programs {
+			asin, program := asin, program
+			run(func() {
+				resp, err := s.GetItemEligibilityPreview(ctx, asin, program)
+
+				var pe ProgramEligibility
+				if err != nil {
+					pe.Err = err
+				} else {
+					pe.Eligible = resp.IsEligibleForProgram
+					pe.Reasons = resp.IneligibilityReasonList
+				}
+
+				mu.Lock()
+				for _, j := range index[asin] {
+					reports[j].Programs[program] = pe
+				}
+				mu.Unlock()
+			})
+		}
+	}
+
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
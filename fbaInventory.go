@@ -0,0 +1,195 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type ReservedQuantity struct {
+	TotalReservedQuantity        int `json:"totalReservedQuantity"`
+	PendingCustomerOrderQuantity int `json:"pendingCustomerOrderQuantity"`
+	PendingTransshipmentQuantity int `json:"pendingTransshipmentQuantity"`
+	FCProcessingQuantity         int `json:"fcProcessingQuantity"`
+}
+
+type ResearchingQuantityEntry struct {
+	Name     string `json:"name"` // researchingQuantityInShortTerm, researchingQuantityInMidTerm, researchingQuantityInLongTerm
+	Quantity int    `json:"quantity"`
+}
+
+type ResearchingQuantity struct {
+	TotalResearchingQuantity     int                        `json:"totalResearchingQuantity"`
+	ResearchingQuantityBreakdown []ResearchingQuantityEntry `json:"researchingQuantityBreakdown"`
+}
+
+type UnfulfillableQuantity struct {
+	TotalUnfulfillableQuantity int `json:"totalUnfulfillableQuantity"`
+	CustomerDamagedQuantity    int `json:"customerDamagedQuantity"`
+	WarehouseDamagedQuantity   int `json:"warehouseDamagedQuantity"`
+	DistributorDamagedQuantity int `json:"distributorDamagedQuantity"`
+	CarrierDamagedQuantity     int `json:"carrierDamagedQuantity"`
+	DefectiveQuantity          int `json:"defectiveQuantity"`
+	ExpiredQuantity            int `json:"expiredQuantity"`
+}
+
+type FutureSupplyQuantity struct {
+	ReservedFutureSupplyQuantity int `json:"reservedFutureSupplyQuantity"`
+	FutureSupplyBuyableQuantity  int `json:"futureSupplyBuyableQuantity"`
+}
+
+type InventoryDetails struct {
+	FulfillableQuantity      int                   `json:"fulfillableQuantity"`
+	InboundWorkingQuantity   int                   `json:"inboundWorkingQuantity"`
+	InboundShippedQuantity   int                   `json:"inboundShippedQuantity"`
+	InboundReceivingQuantity int                   `json:"inboundReceivingQuantity"`
+	ReservedQuantity         ReservedQuantity      `json:"reservedQuantity"`
+	ResearchingQuantity      ResearchingQuantity   `json:"researchingQuantity"`
+	UnfulfillableQuantity    UnfulfillableQuantity `json:"unfulfillableQuantity"`
+	FutureSupplyQuantity     FutureSupplyQuantity  `json:"futureSupplyQuantity"`
+}
+
+// Inbound returns the working, shipped and receiving quantities combined.
+func (d InventoryDetails) Inbound() int {
+	return d.InboundWorkingQuantity + d.InboundShippedQuantity + d.InboundReceivingQuantity
+}
+
+// InventorySummary is the FBA inventory of one SKU in MarketplaceID.
+type InventorySummary struct {
+	MarketplaceID    string           `json:"-"`
+	ASIN             string           `json:"asin"`
+	FnSKU            string           `json:"fnSku"`
+	SellerSKU        string           `json:"sellerSku"`
+	Condition        string           `json:"condition"`
+	InventoryDetails InventoryDetails `json:"inventoryDetails"`
+	LastUpdatedTime  time.Time        `json:"lastUpdatedTime"`
+	ProductName      string           `json:"productName"`
+	TotalQuantity    int              `json:"totalQuantity"`
+	Stores           []string         `json:"stores"`
+}
+
+// GetInventorySummariesRequest filters summaries. StartDateTime returns SKUs
+// changed since then. SellerSKUs is sent in batches of 50; SellerSKU
+// selects a single SKU and cannot be combined with it. MarketplaceID
+// defaults to the client's marketplace.
+type GetInventorySummariesRequest struct {
+	MarketplaceID string
+	StartDateTime time.Time
+	SellerSKUs    []string
+	SellerSKU     string
+}
+
+const maxInventorySKUs = 50
+
+func (s *Client) getInventorySummaries(ctx context.Context, qs url.Values) ([]InventorySummary, string, error) {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		Path:     "/fba/inventory/v1/summaries",
+		RawQuery: qs.Encode(),
+	}
+
+	req := Request{
+		Operation:     "fbaInventory.getInventorySummaries",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	var resp struct {
+		Payload struct {
+			InventorySummaries []InventorySummary `json:"inventorySummaries"`
+		} `json:"payload"`
+		Pagination struct {
+			NextToken string `json:"nextToken"`
+		} `json:"pagination"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, "", fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return resp.Payload.InventorySummaries, resp.Pagination.NextToken, nil
+}
+
+func (s *Client) paginateInventorySummaries(ctx context.Context, qs url.Values, marketplaceID string) ([]InventorySummary, error) {
+	var summaries []InventorySummary
+	for page := 1; ; page++ {
+		res, next, err := s.getInventorySummaries(ctx, qs)
+		if err != nil {
+			return nil, err
+		}
+		for i := range res {
+			res[i].MarketplaceID = marketplaceID
+		}
+		summaries = append(summaries, res...)
+
+		s.logger().LogAttrs(ctx, slog.LevelDebug, "fetched inventory summaries page",
+			slog.Int("page", page),
+			slog.Int("summaries", len(summaries)),
+		)
+		if next == "" {
+			return summaries, nil
+		}
+		qs.Set("nextToken", next)
+	}
+}
+
+// GetInventorySummaries returns detailed FBA inventory, following nextToken.
+func (s *Client) GetInventorySummaries(ctx context.Context, opts *GetInventorySummariesRequest) ([]InventorySummary, error) {
+	if opts == nil {
+		opts = &GetInventorySummariesRequest{}
+	}
+	if opts.SellerSKU != "" && len(opts.SellerSKUs) > 0 {
+		return nil, fmt.Errorf("SellerSKU and SellerSKUs cannot be combined")
+	}
+
+	marketplaceID := opts.MarketplaceID
+	if marketplaceID == "" {
+		marketplaceID = s.Marketplace.ID
+	}
+
+	base := url.Values{}
+	base.Set("details", "true")
+	base.Set("granularityType", "Marketplace")
+	base.Set("granularityId", marketplaceID)
+	base.Set("marketplaceIds", marketplaceID)
+	if !opts.StartDateTime.IsZero() {
+		base.Set("startDateTime", opts.StartDateTime.Format(time.RFC3339))
+	}
+	if opts.SellerSKU != "" {
+		base.Set("sellerSku", opts.SellerSKU)
+	}
+
+	if len(opts.SellerSKUs) == 0 {
+		return s.paginateInventorySummaries(ctx, base, marketplaceID)
+	}
+
+	var summaries []InventorySummary
+	for i := 0; i < len(opts.SellerSKUs); i += maxInventorySKUs {
+		end := min(i+maxInventorySKUs, len(opts.SellerSKUs))
+
+		qs := url.Values{}
+		for k, v := range base {
+			qs[k] = v
+		}
+		qs.Set("sellerSkus", strings.Join(opts.SellerSKUs[i:end], ","))
+
+		res, err := s.paginateInventorySummaries(ctx, qs, marketplaceID)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, res...)
+	}
+	return summaries, nil
+}
@@ -0,0 +1,1110 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// financesMoney is the {CurrencyCode, CurrencyAmount} form Finances v0 uses
+// for amounts. Response types decode it in UnmarshalJSON and expose Money.
+type financesMoney struct {
+	CurrencyCode   string  `json:"CurrencyCode"`
+	CurrencyAmount Decimal `json:"CurrencyAmount"`
+}
+
+func (m *financesMoney) money() *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{CurrencyCode: m.CurrencyCode, Amount: m.CurrencyAmount}
+}
+
+type FinancialEventGroup struct {
+	FinancialEventGroupID    string    `json:"FinancialEventGroupId"`
+	ProcessingStatus         string    `json:"ProcessingStatus"`   // Open, Closed
+	FundTransferStatus       string    `json:"FundTransferStatus"` // Succeeded, Failed, Processing, Unknown
+	OriginalTotal            *Money    `json:"OriginalTotal"`
+	ConvertedTotal           *Money    `json:"ConvertedTotal"`
+	FundTransferDate         time.Time `json:"FundTransferDate"`
+	TraceID                  string    `json:"TraceId"`
+	AccountTail              string    `json:"AccountTail"`
+	BeginningBalance         *Money    `json:"BeginningBalance"`
+	FinancialEventGroupStart time.Time `json:"FinancialEventGroupStart"`
+	FinancialEventGroupEnd   time.Time `json:"FinancialEventGroupEnd"`
+}
+
+func (f *FinancialEventGroup) UnmarshalJSON(b []byte) error {
+	type alias FinancialEventGroup
+	var v struct {
+		*alias
+		OriginalTotal    *financesMoney `json:"OriginalTotal"`
+		ConvertedTotal   *financesMoney `json:"ConvertedTotal"`
+		BeginningBalance *financesMoney `json:"BeginningBalance"`
+	}
+	v.alias = (*alias)(f)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	f.OriginalTotal = v.OriginalTotal.money()
+	f.ConvertedTotal = v.ConvertedTotal.money()
+	f.BeginningBalance = v.BeginningBalance.money()
+	return nil
+}
+
+type ChargeComponent struct {
+	ChargeType   string `json:"ChargeType"`
+	ChargeAmount Money  `json:"ChargeAmount"`
+}
+
+func (c *ChargeComponent) UnmarshalJSON(b []byte) error {
+	type alias ChargeComponent
+	var v struct {
+		*alias
+		ChargeAmount financesMoney `json:"ChargeAmount"`
+	}
+	v.alias = (*alias)(c)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	c.ChargeAmount = *v.ChargeAmount.money()
+	return nil
+}
+
+type FeeComponent struct {
+	FeeType   string `json:"FeeType"`
+	FeeAmount Money  `json:"FeeAmount"`
+}
+
+func (f *FeeComponent) UnmarshalJSON(b []byte) error {
+	type alias FeeComponent
+	var v struct {
+		*alias
+		FeeAmount financesMoney `json:"FeeAmount"`
+	}
+	v.alias = (*alias)(f)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	f.FeeAmount = *v.FeeAmount.money()
+	return nil
+}
+
+type DirectPayment struct {
+	DirectPaymentType   string `json:"DirectPaymentType"`
+	DirectPaymentAmount Money  `json:"DirectPaymentAmount"`
+}
+
+func (d *DirectPayment) UnmarshalJSON(b []byte) error {
+	type alias DirectPayment
+	var v struct {
+		*alias
+		DirectPaymentAmount financesMoney `json:"DirectPaymentAmount"`
+	}
+	v.alias = (*alias)(d)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	d.DirectPaymentAmount = *v.DirectPaymentAmount.money()
+	return nil
+}
+
+type TaxWithheldComponent struct {
+	TaxCollectionModel string            `json:"TaxCollectionModel"` // MarketplaceFacilitator, Standard
+	TaxesWithheld      []ChargeComponent `json:"TaxesWithheld"`
+}
+
+type Promotion struct {
+	PromotionType   string `json:"PromotionType"`
+	PromotionID     string `json:"PromotionId"`
+	PromotionAmount Money  `json:"PromotionAmount"`
+}
+
+func (p *Promotion) UnmarshalJSON(b []byte) error {
+	type alias Promotion
+	var v struct {
+		*alias
+		PromotionAmount financesMoney `json:"PromotionAmount"`
+	}
+	v.alias = (*alias)(p)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	p.PromotionAmount = *v.PromotionAmount.money()
+	return nil
+}
+
+type ShipmentItem struct {
+	SellerSKU                string                 `json:"SellerSKU"`
+	OrderItemID              string                 `json:"OrderItemId"`
+	OrderAdjustmentItemID    string                 `json:"OrderAdjustmentItemId"`
+	QuantityShipped          int                    `json:"QuantityShipped"`
+	ItemChargeList           []ChargeComponent      `json:"ItemChargeList"`
+	ItemChargeAdjustmentList []ChargeComponent      `json:"ItemChargeAdjustmentList"`
+	ItemFeeList              []FeeComponent         `json:"ItemFeeList"`
+	ItemFeeAdjustmentList    []FeeComponent         `json:"ItemFeeAdjustmentList"`
+	ItemTaxWithheldList      []TaxWithheldComponent `json:"ItemTaxWithheldList"`
+	PromotionList            []Promotion            `json:"PromotionList"`
+	PromotionAdjustmentList  []Promotion            `json:"PromotionAdjustmentList"`
+	CostOfPointsGranted      *Money                 `json:"CostOfPointsGranted"`
+	CostOfPointsReturned     *Money                 `json:"CostOfPointsReturned"`
+}
+
+func (s *ShipmentItem) UnmarshalJSON(b []byte) error {
+	type alias ShipmentItem
+	var v struct {
+		*alias
+		CostOfPointsGranted  *financesMoney `json:"CostOfPointsGranted"`
+		CostOfPointsReturned *financesMoney `json:"CostOfPointsReturned"`
+	}
+	v.alias = (*alias)(s)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	s.CostOfPointsGranted = v.CostOfPointsGranted.money()
+	s.CostOfPointsReturned = v.CostOfPointsReturned.money()
+	return nil
+}
+
+// ShipmentEvent is used for shipment, refund, guarantee claim and chargeback
+// events. Refunds and other reversals use the adjustment lists.
+type ShipmentEvent struct {
+	AmazonOrderID              string            `json:"AmazonOrderId"`
+	SellerOrderID              string            `json:"SellerOrderId"`
+	MarketplaceName            string            `json:"MarketplaceName"`
+	StoreName                  string            `json:"StoreName"`
+	OrderChargeList            []ChargeComponent `json:"OrderChargeList"`
+	OrderChargeAdjustmentList  []ChargeComponent `json:"OrderChargeAdjustmentList"`
+	ShipmentFeeList            []FeeComponent    `json:"ShipmentFeeList"`
+	ShipmentFeeAdjustmentList  []FeeComponent    `json:"ShipmentFeeAdjustmentList"`
+	OrderFeeList               []FeeComponent    `json:"OrderFeeList"`
+	OrderFeeAdjustmentList     []FeeComponent    `json:"OrderFeeAdjustmentList"`
+	DirectPaymentList          []DirectPayment   `json:"DirectPaymentList"`
+	PostedDate                 time.Time         `json:"PostedDate"`
+	ShipmentItemList           []ShipmentItem    `json:"ShipmentItemList"`
+	ShipmentItemAdjustmentList []ShipmentItem    `json:"ShipmentItemAdjustmentList"`
+}
+
+type ServiceFeeEvent struct {
+	AmazonOrderID  string         `json:"AmazonOrderId"`
+	FeeReason      string         `json:"FeeReason"`
+	FeeList        []FeeComponent `json:"FeeList"`
+	SellerSKU      string         `json:"SellerSKU"`
+	FnSKU          string         `json:"FnSKU"`
+	FeeDescription string         `json:"FeeDescription"`
+	ASIN           string         `json:"ASIN"`
+	StoreName      string         `json:"StoreName"`
+}
+
+type AdjustmentItem struct {
+	Quantity           string `json:"Quantity"`
+	PerUnitAmount      *Money `json:"PerUnitAmount"`
+	TotalAmount        *Money `json:"TotalAmount"`
+	SellerSKU          string `json:"SellerSKU"`
+	FnSKU              string `json:"FnSKU"`
+	ProductDescription string `json:"ProductDescription"`
+	ASIN               string `json:"ASIN"`
+	TransactionNumber  string `json:"TransactionNumber"`
+}
+
+func (a *AdjustmentItem) UnmarshalJSON(b []byte) error {
+	type alias AdjustmentItem
+	var v struct {
+		*alias
+		PerUnitAmount *financesMoney `json:"PerUnitAmount"`
+		TotalAmount   *financesMoney `json:"TotalAmount"`
+	}
+	v.alias = (*alias)(a)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	a.PerUnitAmount = v.PerUnitAmount.money()
+	a.TotalAmount = v.TotalAmount.money()
+	return nil
+}
+
+type AdjustmentEvent struct {
+	AdjustmentType     string           `json:"AdjustmentType"`
+	PostedDate         time.Time        `json:"PostedDate"`
+	StoreName          string           `json:"StoreName"`
+	AdjustmentAmount   Money            `json:"AdjustmentAmount"`
+	AdjustmentItemList []AdjustmentItem `json:"AdjustmentItemList"`
+}
+
+func (a *AdjustmentEvent) UnmarshalJSON(b []byte) error {
+	type alias AdjustmentEvent
+	var v struct {
+		*alias
+		AdjustmentAmount financesMoney `json:"AdjustmentAmount"`
+	}
+	v.alias = (*alias)(a)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	a.AdjustmentAmount = *v.AdjustmentAmount.money()
+	return nil
+}
+
+type FBALiquidationEvent struct {
+	PostedDate                time.Time `json:"PostedDate"`
+	OriginalRemovalOrderID    string    `json:"OriginalRemovalOrderId"`
+	LiquidationProceedsAmount Money     `json:"LiquidationProceedsAmount"`
+	LiquidationFeeAmount      Money     `json:"LiquidationFeeAmount"`
+}
+
+func (f *FBALiquidationEvent) UnmarshalJSON(b []byte) error {
+	type alias FBALiquidationEvent
+	var v struct {
+		*alias
+		LiquidationProceedsAmount financesMoney `json:"LiquidationProceedsAmount"`
+		LiquidationFeeAmount      financesMoney `json:"LiquidationFeeAmount"`
+	}
+	v.alias = (*alias)(f)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	f.LiquidationProceedsAmount = *v.LiquidationProceedsAmount.money()
+	f.LiquidationFeeAmount = *v.LiquidationFeeAmount.money()
+	return nil
+}
+
+type PayWithAmazonEvent struct {
+	SellerOrderID         string          `json:"SellerOrderId"`
+	TransactionPostedDate time.Time       `json:"TransactionPostedDate"`
+	BusinessObjectType    string          `json:"BusinessObjectType"`
+	SalesChannel          string          `json:"SalesChannel"`
+	Charge                ChargeComponent `json:"Charge"`
+	FeeList               []FeeComponent  `json:"FeeList"`
+	PaymentAmountType     string          `json:"PaymentAmountType"`
+	AmountDescription     string          `json:"AmountDescription"`
+	FulfillmentChannel    string          `json:"FulfillmentChannel"`
+	StoreName             string          `json:"StoreName"`
+}
+
+type SolutionProviderCreditEvent struct {
+	ProviderTransactionType string    `json:"ProviderTransactionType"`
+	SellerOrderID           string    `json:"SellerOrderId"`
+	MarketplaceID           string    `json:"MarketplaceId"`
+	MarketplaceCountryCode  string    `json:"MarketplaceCountryCode"`
+	SellerID                string    `json:"SellerId"`
+	SellerStoreName         string    `json:"SellerStoreName"`
+	ProviderID              string    `json:"ProviderId"`
+	ProviderStoreName       string    `json:"ProviderStoreName"`
+	TransactionAmount       Money     `json:"TransactionAmount"`
+	TransactionCreationDate time.Time `json:"TransactionCreationDate"`
+}
+
+func (s *SolutionProviderCreditEvent) UnmarshalJSON(b []byte) error {
+	type alias SolutionProviderCreditEvent
+	var v struct {
+		*alias
+		TransactionAmount financesMoney `json:"TransactionAmount"`
+	}
+	v.alias = (*alias)(s)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	s.TransactionAmount = *v.TransactionAmount.money()
+	return nil
+}
+
+type RetrochargeEvent struct {
+	RetrochargeEventType       string                 `json:"RetrochargeEventType"`
+	AmazonOrderID              string                 `json:"AmazonOrderId"`
+	PostedDate                 time.Time              `json:"PostedDate"`
+	BaseTax                    *Money                 `json:"BaseTax"`
+	ShippingTax                *Money                 `json:"ShippingTax"`
+	MarketplaceName            string                 `json:"MarketplaceName"`
+	RetrochargeTaxWithheldList []TaxWithheldComponent `json:"RetrochargeTaxWithheldList"`
+}
+
+func (r *RetrochargeEvent) UnmarshalJSON(b []byte) error {
+	type alias RetrochargeEvent
+	var v struct {
+		*alias
+		BaseTax     *financesMoney `json:"BaseTax"`
+		ShippingTax *financesMoney `json:"ShippingTax"`
+	}
+	v.alias = (*alias)(r)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.BaseTax = v.BaseTax.money()
+	r.ShippingTax = v.ShippingTax.money()
+	return nil
+}
+
+type RentalTransactionEvent struct {
+	AmazonOrderID         string                 `json:"AmazonOrderId"`
+	RentalEventType       string                 `json:"RentalEventType"`
+	ExtensionLength       int                    `json:"ExtensionLength"`
+	PostedDate            time.Time              `json:"PostedDate"`
+	RentalChargeList      []ChargeComponent      `json:"RentalChargeList"`
+	RentalFeeList         []FeeComponent         `json:"RentalFeeList"`
+	MarketplaceName       string                 `json:"MarketplaceName"`
+	RentalInitialValue    *Money                 `json:"RentalInitialValue"`
+	RentalReimbursement   *Money                 `json:"RentalReimbursement"`
+	RentalTaxWithheldList []TaxWithheldComponent `json:"RentalTaxWithheldList"`
+}
+
+func (r *RentalTransactionEvent) UnmarshalJSON(b []byte) error {
+	type alias RentalTransactionEvent
+	var v struct {
+		*alias
+		RentalInitialValue  *financesMoney `json:"RentalInitialValue"`
+		RentalReimbursement *financesMoney `json:"RentalReimbursement"`
+	}
+	v.alias = (*alias)(r)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.RentalInitialValue = v.RentalInitialValue.money()
+	r.RentalReimbursement = v.RentalReimbursement.money()
+	return nil
+}
+
+type ProductAdsPaymentEvent struct {
+	PostedDate       time.Time `json:"postedDate"`
+	TransactionType  string    `json:"transactionType"` // Charge, Refund
+	InvoiceID        string    `json:"invoiceId"`
+	BaseValue        Money     `json:"baseValue"`
+	TaxValue         Money     `json:"taxValue"`
+	TransactionValue Money     `json:"transactionValue"`
+}
+
+func (p *ProductAdsPaymentEvent) UnmarshalJSON(b []byte) error {
+	type alias ProductAdsPaymentEvent
+	var v struct {
+		*alias
+		BaseValue        financesMoney `json:"baseValue"`
+		TaxValue         financesMoney `json:"taxValue"`
+		TransactionValue financesMoney `json:"transactionValue"`
+	}
+	v.alias = (*alias)(p)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	p.BaseValue = *v.BaseValue.money()
+	p.TaxValue = *v.TaxValue.money()
+	p.TransactionValue = *v.TransactionValue.money()
+	return nil
+}
+
+type SellerDealPaymentEvent struct {
+	PostedDate      time.Time `json:"postedDate"`
+	DealID          string    `json:"dealId"`
+	DealDescription string    `json:"dealDescription"`
+	EventType       string    `json:"eventType"`
+	FeeType         string    `json:"feeType"`
+	FeeAmount       Money     `json:"feeAmount"`
+	TaxAmount       Money     `json:"taxAmount"`
+	TotalAmount     Money     `json:"totalAmount"`
+}
+
+func (s *SellerDealPaymentEvent) UnmarshalJSON(b []byte) error {
+	type alias SellerDealPaymentEvent
+	var v struct {
+		*alias
+		FeeAmount   financesMoney `json:"feeAmount"`
+		TaxAmount   financesMoney `json:"taxAmount"`
+		TotalAmount financesMoney `json:"totalAmount"`
+	}
+	v.alias = (*alias)(s)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	s.FeeAmount = *v.FeeAmount.money()
+	s.TaxAmount = *v.TaxAmount.money()
+	s.TotalAmount = *v.TotalAmount.money()
+	return nil
+}
+
+type DebtRecoveryItem struct {
+	RecoveryAmount Money     `json:"RecoveryAmount"`
+	OriginalAmount Money     `json:"OriginalAmount"`
+	GroupBeginDate time.Time `json:"GroupBeginDate"`
+	GroupEndDate   time.Time `json:"GroupEndDate"`
+}
+
+func (d *DebtRecoveryItem) UnmarshalJSON(b []byte) error {
+	type alias DebtRecoveryItem
+	var v struct {
+		*alias
+		RecoveryAmount financesMoney `json:"RecoveryAmount"`
+		OriginalAmount financesMoney `json:"OriginalAmount"`
+	}
+	v.alias = (*alias)(d)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	d.RecoveryAmount = *v.RecoveryAmount.money()
+	d.OriginalAmount = *v.OriginalAmount.money()
+	return nil
+}
+
+type ChargeInstrument struct {
+	Description string `json:"Description"`
+	Tail        string `json:"Tail"`
+	Amount      Money  `json:"Amount"`
+}
+
+func (c *ChargeInstrument) UnmarshalJSON(b []byte) error {
+	type alias ChargeInstrument
+	var v struct {
+		*alias
+		Amount financesMoney `json:"Amount"`
+	}
+	v.alias = (*alias)(c)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	c.Amount = *v.Amount.money()
+	return nil
+}
+
+type DebtRecoveryEvent struct {
+	DebtRecoveryType     string             `json:"DebtRecoveryType"`
+	RecoveryAmount       Money              `json:"RecoveryAmount"`
+	OverPaymentCredit    *Money             `json:"OverPaymentCredit"`
+	DebtRecoveryItemList []DebtRecoveryItem `json:"DebtRecoveryItemList"`
+	ChargeInstrumentList []ChargeInstrument `json:"ChargeInstrumentList"`
+}
+
+func (d *DebtRecoveryEvent) UnmarshalJSON(b []byte) error {
+	type alias DebtRecoveryEvent
+	var v struct {
+		*alias
+		RecoveryAmount    financesMoney  `json:"RecoveryAmount"`
+		OverPaymentCredit *financesMoney `json:"OverPaymentCredit"`
+	}
+	v.alias = (*alias)(d)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	d.RecoveryAmount = *v.RecoveryAmount.money()
+	d.OverPaymentCredit = v.OverPaymentCredit.money()
+	return nil
+}
+
+type LoanServicingEvent struct {
+	LoanAmount              Money  `json:"LoanAmount"`
+	SourceBusinessEventType string `json:"SourceBusinessEventType"`
+}
+
+func (l *LoanServicingEvent) UnmarshalJSON(b []byte) error {
+	type alias LoanServicingEvent
+	var v struct {
+		*alias
+		LoanAmount financesMoney `json:"LoanAmount"`
+	}
+	v.alias = (*alias)(l)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	l.LoanAmount = *v.LoanAmount.money()
+	return nil
+}
+
+type SAFETReimbursementItem struct {
+	ItemChargeList     []ChargeComponent `json:"itemChargeList"`
+	ProductDescription string            `json:"productDescription"`
+	Quantity           string            `json:"quantity"`
+}
+
+type SAFETReimbursementEvent struct {
+	PostedDate                 time.Time                `json:"PostedDate"`
+	SAFETClaimID               string                   `json:"SAFETClaimId"`
+	ReimbursedAmount           Money                    `json:"ReimbursedAmount"`
+	ReasonCode                 string                   `json:"ReasonCode"`
+	SAFETReimbursementItemList []SAFETReimbursementItem `json:"SAFETReimbursementItemList"`
+}
+
+func (s *SAFETReimbursementEvent) UnmarshalJSON(b []byte) error {
+	type alias SAFETReimbursementEvent
+	var v struct {
+		*alias
+		ReimbursedAmount financesMoney `json:"ReimbursedAmount"`
+	}
+	v.alias = (*alias)(s)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	s.ReimbursedAmount = *v.ReimbursedAmount.money()
+	return nil
+}
+
+type SellerReviewEnrollmentPaymentEvent struct {
+	PostedDate      time.Time        `json:"PostedDate"`
+	EnrollmentID    string           `json:"EnrollmentId"`
+	ParentASIN      string           `json:"ParentASIN"`
+	FeeComponent    *FeeComponent    `json:"FeeComponent"`
+	ChargeComponent *ChargeComponent `json:"ChargeComponent"`
+	TotalAmount     Money            `json:"TotalAmount"`
+}
+
+func (s *SellerReviewEnrollmentPaymentEvent) UnmarshalJSON(b []byte) error {
+	type alias SellerReviewEnrollmentPaymentEvent
+	var v struct {
+		*alias
+		TotalAmount financesMoney `json:"TotalAmount"`
+	}
+	v.alias = (*alias)(s)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	s.TotalAmount = *v.TotalAmount.money()
+	return nil
+}
+
+type CouponPaymentEvent struct {
+	PostedDate              time.Time        `json:"PostedDate"`
+	CouponID                string           `json:"CouponId"`
+	SellerCouponDescription string           `json:"SellerCouponDescription"`
+	ClipOrRedemptionCount   int64            `json:"ClipOrRedemptionCount"`
+	PaymentEventID          string           `json:"PaymentEventId"`
+	FeeComponent            *FeeComponent    `json:"FeeComponent"`
+	ChargeComponent         *ChargeComponent `json:"ChargeComponent"`
+	TotalAmount             Money            `json:"TotalAmount"`
+}
+
+func (c *CouponPaymentEvent) UnmarshalJSON(b []byte) error {
+	type alias CouponPaymentEvent
+	var v struct {
+		*alias
+		TotalAmount financesMoney `json:"TotalAmount"`
+	}
+	v.alias = (*alias)(c)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	c.TotalAmount = *v.TotalAmount.money()
+	return nil
+}
+
+type ImagingServicesFeeEvent struct {
+	ImagingRequestBillingItemID string         `json:"ImagingRequestBillingItemID"`
+	ASIN                        string         `json:"ASIN"`
+	PostedDate                  time.Time      `json:"PostedDate"`
+	FeeList                     []FeeComponent `json:"FeeList"`
+}
+
+type NetworkComminglingTransactionEvent struct {
+	TransactionType    string    `json:"TransactionType"`
+	PostedDate         time.Time `json:"PostedDate"`
+	NetCoTransactionID string    `json:"NetCoTransactionID"`
+	SwapReason         string    `json:"SwapReason"`
+	ASIN               string    `json:"ASIN"`
+	MarketplaceID      string    `json:"MarketplaceId"`
+	TaxExclusiveAmount Money     `json:"TaxExclusiveAmount"`
+	TaxAmount          Money     `json:"TaxAmount"`
+}
+
+func (n *NetworkComminglingTransactionEvent) UnmarshalJSON(b []byte) error {
+	type alias NetworkComminglingTransactionEvent
+	var v struct {
+		*alias
+		TaxExclusiveAmount financesMoney `json:"TaxExclusiveAmount"`
+		TaxAmount          financesMoney `json:"TaxAmount"`
+	}
+	v.alias = (*alias)(n)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	n.TaxExclusiveAmount = *v.TaxExclusiveAmount.money()
+	n.TaxAmount = *v.TaxAmount.money()
+	return nil
+}
+
+// AffordabilityExpenseEvent is used for both expenses and their reversals.
+type AffordabilityExpenseEvent struct {
+	AmazonOrderID   string    `json:"AmazonOrderId"`
+	PostedDate      time.Time `json:"PostedDate"`
+	MarketplaceID   string    `json:"MarketplaceId"`
+	TransactionType string    `json:"TransactionType"`
+	BaseExpense     Money     `json:"BaseExpense"`
+	TaxTypeCGST     Money     `json:"TaxTypeCGST"`
+	TaxTypeSGST     Money     `json:"TaxTypeSGST"`
+	TaxTypeIGST     Money     `json:"TaxTypeIGST"`
+	TotalExpense    Money     `json:"TotalExpense"`
+}
+
+func (a *AffordabilityExpenseEvent) UnmarshalJSON(b []byte) error {
+	type alias AffordabilityExpenseEvent
+	var v struct {
+		*alias
+		BaseExpense  financesMoney `json:"BaseExpense"`
+		TaxTypeCGST  financesMoney `json:"TaxTypeCGST"`
+		TaxTypeSGST  financesMoney `json:"TaxTypeSGST"`
+		TaxTypeIGST  financesMoney `json:"TaxTypeIGST"`
+		TotalExpense financesMoney `json:"TotalExpense"`
+	}
+	v.alias = (*alias)(a)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	a.BaseExpense = *v.BaseExpense.money()
+	a.TaxTypeCGST = *v.TaxTypeCGST.money()
+	a.TaxTypeSGST = *v.TaxTypeSGST.money()
+	a.TaxTypeIGST = *v.TaxTypeIGST.money()
+	a.TotalExpense = *v.TotalExpense.money()
+	return nil
+}
+
+type RemovalShipmentItem struct {
+	RemovalShipmentItemID string `json:"RemovalShipmentItemId"`
+	TaxCollectionModel    string `json:"TaxCollectionModel"`
+	FulfillmentNetworkSKU string `json:"FulfillmentNetworkSKU"`
+	Quantity              int    `json:"Quantity"`
+	Revenue               *Money `json:"Revenue"`
+	FeeAmount             *Money `json:"FeeAmount"`
+	TaxAmount             *Money `json:"TaxAmount"`
+	TaxWithheld           *Money `json:"TaxWithheld"`
+}
+
+func (r *RemovalShipmentItem) UnmarshalJSON(b []byte) error {
+	type alias RemovalShipmentItem
+	var v struct {
+		*alias
+		Revenue     *financesMoney `json:"Revenue"`
+		FeeAmount   *financesMoney `json:"FeeAmount"`
+		TaxAmount   *financesMoney `json:"TaxAmount"`
+		TaxWithheld *financesMoney `json:"TaxWithheld"`
+	}
+	v.alias = (*alias)(r)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.Revenue = v.Revenue.money()
+	r.FeeAmount = v.FeeAmount.money()
+	r.TaxAmount = v.TaxAmount.money()
+	r.TaxWithheld = v.TaxWithheld.money()
+	return nil
+}
+
+type RemovalShipmentEvent struct {
+	PostedDate              time.Time             `json:"PostedDate"`
+	MerchantOrderID         string                `json:"MerchantOrderId"`
+	OrderID                 string                `json:"OrderId"`
+	TransactionType         string                `json:"TransactionType"`
+	StoreName               string                `json:"StoreName"`
+	RemovalShipmentItemList []RemovalShipmentItem `json:"RemovalShipmentItemList"`
+}
+
+type RemovalShipmentItemAdjustment struct {
+	RemovalShipmentItemID string `json:"RemovalShipmentItemId"`
+	TaxCollectionModel    string `json:"TaxCollectionModel"`
+	FulfillmentNetworkSKU string `json:"FulfillmentNetworkSKU"`
+	AdjustedQuantity      int    `json:"AdjustedQuantity"`
+	RevenueAdjustment     *Money `json:"RevenueAdjustment"`
+	TaxAmountAdjustment   *Money `json:"TaxAmountAdjustment"`
+	TaxWithheldAdjustment *Money `json:"TaxWithheldAdjustment"`
+}
+
+func (r *RemovalShipmentItemAdjustment) UnmarshalJSON(b []byte) error {
+	type alias RemovalShipmentItemAdjustment
+	var v struct {
+		*alias
+		RevenueAdjustment     *financesMoney `json:"RevenueAdjustment"`
+		TaxAmountAdjustment   *financesMoney `json:"TaxAmountAdjustment"`
+		TaxWithheldAdjustment *financesMoney `json:"TaxWithheldAdjustment"`
+	}
+	v.alias = (*alias)(r)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.RevenueAdjustment = v.RevenueAdjustment.money()
+	r.TaxAmountAdjustment = v.TaxAmountAdjustment.money()
+	r.TaxWithheldAdjustment = v.TaxWithheldAdjustment.money()
+	return nil
+}
+
+type RemovalShipmentAdjustmentEvent struct {
+	PostedDate                        time.Time                       `json:"PostedDate"`
+	AdjustmentEventID                 string                          `json:"AdjustmentEventId"`
+	MerchantOrderID                   string                          `json:"MerchantOrderId"`
+	OrderID                           string                          `json:"OrderId"`
+	TransactionType                   string                          `json:"TransactionType"`
+	RemovalShipmentItemAdjustmentList []RemovalShipmentItemAdjustment `json:"RemovalShipmentItemAdjustmentList"`
+}
+
+type TrialShipmentEvent struct {
+	AmazonOrderID         string         `json:"AmazonOrderId"`
+	FinancialEventGroupID string         `json:"FinancialEventGroupId"`
+	PostedDate            time.Time      `json:"PostedDate"`
+	SKU                   string         `json:"SKU"`
+	FeeList               []FeeComponent `json:"FeeList"`
+}
+
+type TDSReimbursementEvent struct {
+	PostedDate       time.Time `json:"PostedDate"`
+	TDSOrderID       string    `json:"TDSOrderId"`
+	ReimbursedAmount Money     `json:"ReimbursedAmount"`
+}
+
+func (t *TDSReimbursementEvent) UnmarshalJSON(b []byte) error {
+	type alias TDSReimbursementEvent
+	var v struct {
+		*alias
+		ReimbursedAmount financesMoney `json:"ReimbursedAmount"`
+	}
+	v.alias = (*alias)(t)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	t.ReimbursedAmount = *v.ReimbursedAmount.money()
+	return nil
+}
+
+type AdhocDisbursementEvent struct {
+	TransactionType   string    `json:"TransactionType"`
+	PostedDate        time.Time `json:"PostedDate"`
+	TransactionID     string    `json:"TransactionId"`
+	TransactionAmount Money     `json:"TransactionAmount"`
+}
+
+func (a *AdhocDisbursementEvent) UnmarshalJSON(b []byte) error {
+	type alias AdhocDisbursementEvent
+	var v struct {
+		*alias
+		TransactionAmount financesMoney `json:"TransactionAmount"`
+	}
+	v.alias = (*alias)(a)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	a.TransactionAmount = *v.TransactionAmount.money()
+	return nil
+}
+
+type TaxWithholdingPeriod struct {
+	StartDate time.Time `json:"StartDate"`
+	EndDate   time.Time `json:"EndDate"`
+}
+
+type TaxWithholdingEvent struct {
+	PostedDate           time.Time             `json:"PostedDate"`
+	BaseAmount           Money                 `json:"BaseAmount"`
+	WithheldAmount       Money                 `json:"WithheldAmount"`
+	TaxWithholdingPeriod *TaxWithholdingPeriod `json:"TaxWithholdingPeriod"`
+}
+
+func (t *TaxWithholdingEvent) UnmarshalJSON(b []byte) error {
+	type alias TaxWithholdingEvent
+	var v struct {
+		*alias
+		BaseAmount     financesMoney `json:"BaseAmount"`
+		WithheldAmount financesMoney `json:"WithheldAmount"`
+	}
+	v.alias = (*alias)(t)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	t.BaseAmount = *v.BaseAmount.money()
+	t.WithheldAmount = *v.WithheldAmount.money()
+	return nil
+}
+
+type ChargeRefundTransaction struct {
+	ChargeAmount Money  `json:"ChargeAmount"`
+	ChargeType   string `json:"ChargeType"`
+}
+
+func (c *ChargeRefundTransaction) UnmarshalJSON(b []byte) error {
+	type alias ChargeRefundTransaction
+	var v struct {
+		*alias
+		ChargeAmount financesMoney `json:"ChargeAmount"`
+	}
+	v.alias = (*alias)(c)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	c.ChargeAmount = *v.ChargeAmount.money()
+	return nil
+}
+
+type ChargeRefundEvent struct {
+	PostedDate               time.Time                 `json:"PostedDate"`
+	ReasonCode               string                    `json:"ReasonCode"`
+	ReasonCodeDescription    string                    `json:"ReasonCodeDescription"`
+	ChargeRefundTransactions []ChargeRefundTransaction `json:"ChargeRefundTransactions"`
+}
+
+type FailedAdhocDisbursementEvent struct {
+	FundsTransfersType      string    `json:"FundsTransfersType"`
+	TransferID              string    `json:"TransferId"`
+	DisbursementID          string    `json:"DisbursementId"`
+	PaymentDisbursementType string    `json:"PaymentDisbursementType"`
+	Status                  string    `json:"Status"`
+	TransferAmount          Money     `json:"TransferAmount"`
+	PostedDate              time.Time `json:"PostedDate"`
+}
+
+func (f *FailedAdhocDisbursementEvent) UnmarshalJSON(b []byte) error {
+	type alias FailedAdhocDisbursementEvent
+	var v struct {
+		*alias
+		TransferAmount financesMoney `json:"TransferAmount"`
+	}
+	v.alias = (*alias)(f)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	f.TransferAmount = *v.TransferAmount.money()
+	return nil
+}
+
+// ServiceChargeEvent is used for value added service and capacity
+// reservation billing events.
+type ServiceChargeEvent struct {
+	TransactionType   string    `json:"TransactionType"`
+	PostedDate        time.Time `json:"PostedDate"`
+	Description       string    `json:"Description"`
+	TransactionAmount Money     `json:"TransactionAmount"`
+}
+
+func (s *ServiceChargeEvent) UnmarshalJSON(b []byte) error {
+	type alias ServiceChargeEvent
+	var v struct {
+		*alias
+		TransactionAmount financesMoney `json:"TransactionAmount"`
+	}
+	v.alias = (*alias)(s)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	s.TransactionAmount = *v.TransactionAmount.money()
+	return nil
+}
+
+type FinancialEvents struct {
+	ShipmentEventList                      []ShipmentEvent                      `json:"ShipmentEventList"`
+	ShipmentSettleEventList                []ShipmentEvent                      `json:"ShipmentSettleEventList"`
+	RefundEventList                        []ShipmentEvent                      `json:"RefundEventList"`
+	GuaranteeClaimEventList                []ShipmentEvent                      `json:"GuaranteeClaimEventList"`
+	ChargebackEventList                    []ShipmentEvent                      `json:"ChargebackEventList"`
+	PayWithAmazonEventList                 []PayWithAmazonEvent                 `json:"PayWithAmazonEventList"`
+	ServiceProviderCreditEventList         []SolutionProviderCreditEvent        `json:"ServiceProviderCreditEventList"`
+	RetrochargeEventList                   []RetrochargeEvent                   `json:"RetrochargeEventList"`
+	RentalTransactionEventList             []RentalTransactionEvent             `json:"RentalTransactionEventList"`
+	ProductAdsPaymentEventList             []ProductAdsPaymentEvent             `json:"ProductAdsPaymentEventList"`
+	ServiceFeeEventList                    []ServiceFeeEvent                    `json:"ServiceFeeEventList"`
+	SellerDealPaymentEventList             []SellerDealPaymentEvent             `json:"SellerDealPaymentEventList"`
+	DebtRecoveryEventList                  []DebtRecoveryEvent                  `json:"DebtRecoveryEventList"`
+	LoanServicingEventList                 []LoanServicingEvent                 `json:"LoanServicingEventList"`
+	AdjustmentEventList                    []AdjustmentEvent                    `json:"AdjustmentEventList"`
+	SAFETReimbursementEventList            []SAFETReimbursementEvent            `json:"SAFETReimbursementEventList"`
+	SellerReviewEnrollmentPaymentEventList []SellerReviewEnrollmentPaymentEvent `json:"SellerReviewEnrollmentPaymentEventList"`
+	FBALiquidationEventList                []FBALiquidationEvent                `json:"FBALiquidationEventList"`
+	CouponPaymentEventList                 []CouponPaymentEvent                 `json:"CouponPaymentEventList"`
+	ImagingServicesFeeEventList            []ImagingServicesFeeEvent            `json:"ImagingServicesFeeEventList"`
+	NetworkComminglingTransactionEventList []NetworkComminglingTransactionEvent `json:"NetworkComminglingTransactionEventList"`
+	AffordabilityExpenseEventList          []AffordabilityExpenseEvent          `json:"AffordabilityExpenseEventList"`
+	AffordabilityExpenseReversalEventList  []AffordabilityExpenseEvent          `json:"AffordabilityExpenseReversalEventList"`
+	RemovalShipmentEventList               []RemovalShipmentEvent               `json:"RemovalShipmentEventList"`
+	RemovalShipmentAdjustmentEventList     []RemovalShipmentAdjustmentEvent     `json:"RemovalShipmentAdjustmentEventList"`
+	TrialShipmentEventList                 []TrialShipmentEvent                 `json:"TrialShipmentEventList"`
+	TDSReimbursementEventList              []TDSReimbursementEvent              `json:"TDSReimbursementEventList"`
+	AdhocDisbursementEventList             []AdhocDisbursementEvent             `json:"AdhocDisbursementEventList"`
+	TaxWithholdingEventList                []TaxWithholdingEvent                `json:"TaxWithholdingEventList"`
+	ChargeRefundEventList                  []ChargeRefundEvent                  `json:"ChargeRefundEventList"`
+	FailedAdhocDisbursementEventList       []FailedAdhocDisbursementEvent       `json:"FailedAdhocDisbursementEventList"`
+	ValueAddedServiceChargeEventList       []ServiceChargeEvent                 `json:"ValueAddedServiceChargeEventList"`
+	CapacityReservationBillingEventList    []ServiceChargeEvent                 `json:"CapacityReservationBillingEventList"`
+}
+
+func (e *FinancialEvents) merge(o FinancialEvents) {
+	e.ShipmentEventList = append(e.ShipmentEventList, o.ShipmentEventList...)
+	e.ShipmentSettleEventList = append(e.ShipmentSettleEventList, o.ShipmentSettleEventList...)
+	e.RefundEventList = append(e.RefundEventList, o.RefundEventList...)
+	e.GuaranteeClaimEventList = append(e.GuaranteeClaimEventList, o.GuaranteeClaimEventList...)
+	e.ChargebackEventList = append(e.ChargebackEventList, o.ChargebackEventList...)
+	e.PayWithAmazonEventList = append(e.PayWithAmazonEventList, o.PayWithAmazonEventList...)
+	e.ServiceProviderCreditEventList = append(e.ServiceProviderCreditEventList, o.ServiceProviderCreditEventList...)
+	e.RetrochargeEventList = append(e.RetrochargeEventList, o.RetrochargeEventList...)
+	e.RentalTransactionEventList = append(e.RentalTransactionEventList, o.RentalTransactionEventList...)
+	e.ProductAdsPaymentEventList = append(e.ProductAdsPaymentEventList, o.ProductAdsPaymentEventList...)
+	e.ServiceFeeEventList = append(e.ServiceFeeEventList, o.ServiceFeeEventList...)
+	e.SellerDealPaymentEventList = append(e.SellerDealPaymentEventList, o.SellerDealPaymentEventList...)
+	e.DebtRecoveryEventList = append(e.DebtRecoveryEventList, o.DebtRecoveryEventList...)
+	e.LoanServicingEventList = append(e.LoanServicingEventList, o.LoanServicingEventList...)
+	e.AdjustmentEventList = append(e.AdjustmentEventList, o.AdjustmentEventList...)
+	e.SAFETReimbursementEventList = append(e.SAFETReimbursementEventList, o.SAFETReimbursementEventList...)
+	e.SellerReviewEnrollmentPaymentEventList = append(e.SellerReviewEnrollmentPaymentEventList, o.SellerReviewEnrollmentPaymentEventList...)
+	e.FBALiquidationEventList = append(e.FBALiquidationEventList, o.FBALiquidationEventList...)
+	e.CouponPaymentEventList = append(e.CouponPaymentEventList, o.CouponPaymentEventList...)
+	e.ImagingServicesFeeEventList = append(e.ImagingServicesFeeEventList, o.ImagingServicesFeeEventList...)
+	e.NetworkComminglingTransactionEventList = append(e.NetworkComminglingTransactionEventList, o.NetworkComminglingTransactionEventList...)
+	e.AffordabilityExpenseEventList = append(e.AffordabilityExpenseEventList, o.AffordabilityExpenseEventList...)
+	e.AffordabilityExpenseReversalEventList = append(e.AffordabilityExpenseReversalEventList, o.AffordabilityExpenseReversalEventList...)
+	e.RemovalShipmentEventList = append(e.RemovalShipmentEventList, o.RemovalShipmentEventList...)
+	e.RemovalShipmentAdjustmentEventList = append(e.RemovalShipmentAdjustmentEventList, o.RemovalShipmentAdjustmentEventList...)
+	e.TrialShipmentEventList = append(e.TrialShipmentEventList, o.TrialShipmentEventList...)
+	e.TDSReimbursementEventList = append(e.TDSReimbursementEventList, o.TDSReimbursementEventList...)
+	e.AdhocDisbursementEventList = append(e.AdhocDisbursementEventList, o.AdhocDisbursementEventList...)
+	e.TaxWithholdingEventList = append(e.TaxWithholdingEventList, o.TaxWithholdingEventList...)
+	e.ChargeRefundEventList = append(e.ChargeRefundEventList, o.ChargeRefundEventList...)
+	e.FailedAdhocDisbursementEventList = append(e.FailedAdhocDisbursementEventList, o.FailedAdhocDisbursementEventList...)
+	e.ValueAddedServiceChargeEventList = append(e.ValueAddedServiceChargeEventList, o.ValueAddedServiceChargeEventList...)
+	e.CapacityReservationBillingEventList = append(e.CapacityReservationBillingEventList, o.CapacityReservationBillingEventList...)
+}
+
+// ByOrderID groups shipment and refund events by AmazonOrderId, for
+// reconciling against GetOrders.
+func (e *FinancialEvents) ByOrderID() map[string][]ShipmentEvent {
+	out := map[string][]ShipmentEvent{}
+	for _, list := range [][]ShipmentEvent{e.ShipmentEventList, e.RefundEventList, e.GuaranteeClaimEventList, e.ChargebackEventList} {
+		for _, ev := range list {
+			out[ev.AmazonOrderID] = append(out[ev.AmazonOrderID], ev)
+		}
+	}
+	return out
+}
+
+func (s *Client) financesGet(ctx context.Context, operation, path string, qs url.Values, out any) error {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		RawQuery: qs.Encode(),
+	}
+	setEscapedPath(&u, "/finances/v0"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 2 * time.Second,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resp := struct {
+		Payload any `json:"payload"`
+	}{Payload: out}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// ListFinancialEventGroupsRequest filters groups by start date. Zero values
+// are omitted; Amazon defaults StartedAfter to 180 days ago.
+type ListFinancialEventGroupsRequest struct {
+	StartedAfter      time.Time
+	StartedBefore     time.Time
+	MaxResultsPerPage int
+}
+
+// ListFinancialEventGroups returns every financial event group (settlement
+// period), following NextToken.
+func (s *Client) ListFinancialEventGroups(ctx context.Context, opts *ListFinancialEventGroupsRequest) ([]FinancialEventGroup, error) {
+	if opts == nil {
+		opts = &ListFinancialEventGroupsRequest{}
+	}
+
+	qs := url.Values{}
+	if !opts.StartedAfter.IsZero() {
+		qs.Set("FinancialEventGroupStartedAfter", opts.StartedAfter.Format(time.RFC3339))
+	}
+	if !opts.StartedBefore.IsZero() {
+		qs.Set("FinancialEventGroupStartedBefore", opts.StartedBefore.Format(time.RFC3339))
+	}
+	if opts.MaxResultsPerPage > 0 {
+		qs.Set("MaxResultsPerPage", strconv.Itoa(opts.MaxResultsPerPage))
+	}
+
+	var groups []FinancialEventGroup
+	for page := 1; ; page++ {
+		var resp struct {
+			FinancialEventGroupList []FinancialEventGroup `json:"FinancialEventGroupList"`
+			NextToken               string                `json:"NextToken"`
+		}
+		if err := s.financesGet(ctx, "finances.listFinancialEventGroups", "/financialEventGroups", qs, &resp); err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, resp.FinancialEventGroupList...)
+		s.logger().LogAttrs(ctx, slog.LevelDebug, "fetched financial event groups page",
+			slog.Int("page", page),
+			slog.Int("groups", len(groups)),
+		)
+		if resp.NextToken == "" {
+			return groups, nil
+		}
+		qs.Set("NextToken", resp.NextToken)
+	}
+}
+
+// ListFinancialEventsRequest filters events by posted date. PostedBefore must
+// be at least two minutes in the past.
+type ListFinancialEventsRequest struct {
+	PostedAfter       time.Time
+	PostedBefore      time.Time
+	MaxResultsPerPage int
+}
+
+func (opts *ListFinancialEventsRequest) values() url.Values {
+	qs := url.Values{}
+	if opts == nil {
+		return qs
+	}
+	if !opts.PostedAfter.IsZero() {
+		qs.Set("PostedAfter", opts.PostedAfter.Format(time.RFC3339))
+	}
+	if !opts.PostedBefore.IsZero() {
+		qs.Set("PostedBefore", opts.PostedBefore.Format(time.RFC3339))
+	}
+	if opts.MaxResultsPerPage > 0 {
+		qs.Set("MaxResultsPerPage", strconv.Itoa(opts.MaxResultsPerPage))
+	}
+	return qs
+}
+
+func (s *Client) paginateFinancialEvents(ctx context.Context, operation, path string, qs url.Values) (*FinancialEvents, error) {
+	events := &FinancialEvents{}
+	for page := 1; ; page++ {
+		var resp struct {
+			FinancialEvents FinancialEvents `json:"FinancialEvents"`
+			NextToken       string          `json:"NextToken"`
+		}
+		if err := s.financesGet(ctx, operation, path, qs, &resp); err != nil {
+			return nil, err
+		}
+
+		events.merge(resp.FinancialEvents)
+		s.logger().LogAttrs(ctx, slog.LevelDebug, "fetched financial events page",
+			slog.String("operation", operation),
+			slog.Int("page", page),
+		)
+		if resp.NextToken == "" {
+			return events, nil
+		}
+		qs.Set("NextToken", resp.NextToken)
+	}
+}
+
+// ListFinancialEvents returns all events posted in the requested window,
+// following NextToken. Events can take up to 48 hours to appear.
+func (s *Client) ListFinancialEvents(ctx context.Context, opts *ListFinancialEventsRequest) (*FinancialEvents, error) {
+	return s.paginateFinancialEvents(ctx, "finances.listFinancialEvents", "/financialEvents", opts.values())
+}
+
+func (s *Client) ListFinancialEventsByOrderID(ctx context.Context, orderID string, opts *ListFinancialEventsRequest) (*FinancialEvents, error) {
+	path := "/orders/" + url.PathEscape(orderID) + "/financialEvents"
+	return s.paginateFinancialEvents(ctx, "finances.listFinancialEventsByOrderId", path, opts.values())
+}
+
+func (s *Client) ListFinancialEventsByGroupID(ctx context.Context, groupID string, opts *ListFinancialEventsRequest) (*FinancialEvents, error) {
+	path := "/financialEventGroups/" + url.PathEscape(groupID) + "/financialEvents"
+	return s.paginateFinancialEvents(ctx, "finances.listFinancialEventsByGroupId", path, opts.values())
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,6 +16,8 @@ type ItemEligibilityPreviewResponse struct {
 	Program              string `json:"program"`
 	MarketplaceId        string `json:"marketplaceId"`
 	IsEligibleForProgram bool   `json:"isEligibleForProgram"`
+
+	IneligibilityReasonList []IneligibilityReason `json:"ineligibilityReasonList"`
 }
 
 type FulfillmentInboundProgram string
@@ -37,19 +40,19 @@ func (s *Client) GetItemEligibilityPreview(ctx context.Context, asin string, pro
 		RawQuery: qs.Encode(),
 	}
 
-	req := request{
-		Method: http.MethodGet,
-		URL:    &u,
-		Token:  s.Token,
+	req := Request{
+		Operation:     "fbaInbound.getItemEligibilityPreview",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
 	}
 
-	res, err := s.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 1 * time.Second,
-	})
+	res, err := s.do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
 	var resp struct {
 		Payload ItemEligibilityPreviewResponse `json:"payload"`
@@ -78,6 +81,7 @@ type GetPrepInstructionsResponse struct {
 type PrepInstruction struct {
 	PrepInstructionList []string
 	BarcodeInstruction  string
+	PrepGuidance        string
 }
 
 func (s *Client) GetItemPrepInstructions(ctx context.Context, shipTo string, asins []string) (*GetPrepInstructionsResponse, error) {
@@ -92,19 +96,19 @@ func (s *Client) GetItemPrepInstructions(ctx context.Context, shipTo string, asi
 		RawQuery: qs.Encode(),
 	}
 
-	req := request{
-		Method: http.MethodGet,
-		URL:    &u,
-		Token:  s.Token,
+	req := Request{
+		Operation:     "fbaInbound.getPrepInstructions",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
 	}
 
-	res, err := s.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 1 * time.Second,
-	})
+	res, err := s.do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
 	var resp struct {
 		Payload GetPrepInstructionsResponse `json:"payload"`
@@ -115,3 +119,358 @@ func (s *Client) GetItemPrepInstructions(ctx context.Context, shipTo string, asi
 
 	return &resp.Payload, nil
 }
+
+type InboundGuidance string
+
+var (
+	InboundGuidanceInboundOK             InboundGuidance = "InboundOK"
+	InboundGuidanceInboundNotRecommended InboundGuidance = "InboundNotRecommended"
+)
+
+type GuidanceReason string
+
+var (
+	GuidanceReasonSlowMovingASIN       GuidanceReason = "SlowMovingASIN"
+	GuidanceReasonNoApplicableGuidance GuidanceReason = "NoApplicableGuidance"
+)
+
+type GetInboundGuidanceResponse struct {
+	SKUInboundGuidanceList []struct {
+		SellerSKU          string           `json:"SellerSKU"`
+		ASIN               string           `json:"ASIN"`
+		InboundGuidance    InboundGuidance  `json:"InboundGuidance"`
+		GuidanceReasonList []GuidanceReason `json:"GuidanceReasonList"`
+	} `json:"SKUInboundGuidanceList"`
+
+	InvalidSKUList []struct {
+		SellerSKU   string `json:"SellerSKU"`
+		ErrorReason string `json:"ErrorReason"`
+	} `json:"InvalidSKUList"`
+
+	ASINInboundGuidanceList []struct {
+		ASIN               string           `json:"ASIN"`
+		InboundGuidance    InboundGuidance  `json:"InboundGuidance"`
+		GuidanceReasonList []GuidanceReason `json:"GuidanceReasonList"`
+	} `json:"ASINInboundGuidanceList"`
+
+	InvalidASINList []struct {
+		ASIN        string `json:"ASIN"`
+		ErrorReason string `json:"ErrorReason"`
+	} `json:"InvalidASINList"`
+}
+
+func (s *Client) getInboundGuidance(ctx context.Context, qs url.Values) (*GetInboundGuidanceResponse, error) {
+	qs.Set("MarketplaceId", s.Marketplace.ID)
+
+	var resp GetInboundGuidanceResponse
+	if err := s.inboundV0Get(ctx, "fbaInbound.getInboundGuidance", "/itemsGuidance", qs, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetInboundGuidanceByASIN returns Amazon's inbound guidance for up to 50
+// ASINs in the client's marketplace.
+func (s *Client) GetInboundGuidanceByASIN(ctx context.Context, asins []string) (*GetInboundGuidanceResponse, error) {
+	qs := url.Values{}
+	qs.Set("ASINList", strings.Join(asins, ","))
+	return s.getInboundGuidance(ctx, qs)
+}
+
+// GetInboundGuidanceBySKU returns Amazon's inbound guidance for up to 50
+// seller SKUs in the client's marketplace.
+func (s *Client) GetInboundGuidanceBySKU(ctx context.Context, skus []string) (*GetInboundGuidanceResponse, error) {
+	qs := url.Values{}
+	qs.Set("SellerSKUList", strings.Join(skus, ","))
+	return s.getInboundGuidance(ctx, qs)
+}
+
+type InboundShipmentAddress struct {
+	Name                string `json:"Name"`
+	AddressLine1        string `json:"AddressLine1"`
+	AddressLine2        string `json:"AddressLine2"`
+	DistrictOrCounty    string `json:"DistrictOrCounty"`
+	City                string `json:"City"`
+	StateOrProvinceCode string `json:"StateOrProvinceCode"`
+	CountryCode         string `json:"CountryCode"`
+	PostalCode          string `json:"PostalCode"`
+}
+
+// inboundMoney is the {CurrencyCode, Value} form Fulfillment Inbound v0 uses
+// for amounts.
+type inboundMoney struct {
+	CurrencyCode string  `json:"CurrencyCode"`
+	Value        Decimal `json:"Value"`
+}
+
+func (m *inboundMoney) money() *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{CurrencyCode: m.CurrencyCode, Amount: m.Value}
+}
+
+type BoxContentsFeeDetails struct {
+	TotalUnits int   `json:"TotalUnits"`
+	FeePerUnit Money `json:"FeePerUnit"`
+	TotalFee   Money `json:"TotalFee"`
+}
+
+func (d *BoxContentsFeeDetails) UnmarshalJSON(b []byte) error {
+	type alias BoxContentsFeeDetails
+	var v struct {
+		*alias
+		FeePerUnit inboundMoney `json:"FeePerUnit"`
+		TotalFee   inboundMoney `json:"TotalFee"`
+	}
+	v.alias = (*alias)(d)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	d.FeePerUnit = *v.FeePerUnit.money()
+	d.TotalFee = *v.TotalFee.money()
+	return nil
+}
+
+type InboundShipmentInfo struct {
+	ShipmentID                     string                 `json:"ShipmentId"`
+	ShipmentName                   string                 `json:"ShipmentName"`
+	ShipFromAddress                InboundShipmentAddress `json:"ShipFromAddress"`
+	DestinationFulfillmentCenterID string                 `json:"DestinationFulfillmentCenterId"`
+	ShipmentStatus                 InboundShipmentStatus  `json:"ShipmentStatus"`
+	LabelPrepType                  string                 `json:"LabelPrepType"`
+	AreCasesRequired               bool                   `json:"AreCasesRequired"`
+	ConfirmedNeedByDate            string                 `json:"ConfirmedNeedByDate"`
+	BoxContentsSource              string                 `json:"BoxContentsSource"`
+	EstimatedBoxContentsFee        *BoxContentsFeeDetails `json:"EstimatedBoxContentsFee"`
+}
+
+type PrepDetails struct {
+	PrepInstruction string `json:"PrepInstruction"`
+	PrepOwner       string `json:"PrepOwner"` // AMAZON, SELLER
+}
+
+type InboundShipmentItem struct {
+	ShipmentID            string        `json:"ShipmentId"`
+	SellerSKU             string        `json:"SellerSKU"`
+	FulfillmentNetworkSKU string        `json:"FulfillmentNetworkSKU"`
+	QuantityShipped       int           `json:"QuantityShipped"`
+	QuantityReceived      int           `json:"QuantityReceived"`
+	QuantityInCase        int           `json:"QuantityInCase"`
+	ReleaseDate           string        `json:"ReleaseDate"`
+	PrepDetailsList       []PrepDetails `json:"PrepDetailsList"`
+}
+
+// Discrepancy returns QuantityShipped minus QuantityReceived. It is positive
+// while units are missing or still being received.
+func (i InboundShipmentItem) Discrepancy() int {
+	return i.QuantityShipped - i.QuantityReceived
+}
+
+type InboundShipmentStatus string
+
+var (
+	InboundShipmentStatusWorking   InboundShipmentStatus = "WORKING"
+	InboundShipmentStatusShipped   InboundShipmentStatus = "SHIPPED"
+	InboundShipmentStatusReceiving InboundShipmentStatus = "RECEIVING"
+	InboundShipmentStatusCancelled InboundShipmentStatus = "CANCELLED"
+	InboundShipmentStatusDeleted   InboundShipmentStatus = "DELETED"
+	InboundShipmentStatusClosed    InboundShipmentStatus = "CLOSED"
+	InboundShipmentStatusError     InboundShipmentStatus = "ERROR"
+	InboundShipmentStatusInTransit InboundShipmentStatus = "IN_TRANSIT"
+	InboundShipmentStatusDelivered InboundShipmentStatus = "DELIVERED"
+	InboundShipmentStatusCheckedIn InboundShipmentStatus = "CHECKED_IN"
+)
+
+func (s *Client) inboundV0Get(ctx context.Context, operation, path string, qs url.Values, out any) error {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		RawQuery: qs.Encode(),
+	}
+	setEscapedPath(&u, "/fba/inbound/v0"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resp := struct {
+		Payload any `json:"payload"`
+	}{Payload: out}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// GetShipmentsRequest selects shipments by ShipmentIDs, or by statuses and
+// an optional LastUpdated window.
+type GetShipmentsRequest struct {
+	ShipmentStatuses  []InboundShipmentStatus
+	ShipmentIDs       []string
+	LastUpdatedAfter  time.Time
+	LastUpdatedBefore time.Time
+}
+
+// GetShipments returns the matching inbound shipments, following NextToken.
+func (s *Client) GetShipments(ctx context.Context, opts *GetShipmentsRequest) ([]InboundShipmentInfo, error) {
+	if opts == nil || (len(opts.ShipmentStatuses) == 0 && len(opts.ShipmentIDs) == 0) {
+		return nil, fmt.Errorf("ShipmentStatuses or ShipmentIDs is required")
+	}
+
+	qs := url.Values{}
+	qs.Set("MarketplaceId", s.Marketplace.ID)
+	if len(opts.ShipmentStatuses) > 0 {
+		statuses := make([]string, len(opts.ShipmentStatuses))
+		for i, st := range opts.ShipmentStatuses {
+			statuses[i] = string(st)
+		}
+		qs.Set("ShipmentStatusList", strings.Join(statuses, ","))
+	}
+	if len(opts.ShipmentIDs) > 0 {
+		qs.Set("ShipmentIdList", strings.Join(opts.ShipmentIDs, ","))
+	}
+	if !opts.LastUpdatedAfter.IsZero() || !opts.LastUpdatedBefore.IsZero() {
+		qs.Set("QueryType", "DATE_RANGE")
+		if !opts.LastUpdatedAfter.IsZero() {
+			qs.Set("LastUpdatedAfter", opts.LastUpdatedAfter.Format(time.RFC3339))
+		}
+		if !opts.LastUpdatedBefore.IsZero() {
+			qs.Set("LastUpdatedBefore", opts.LastUpdatedBefore.Format(time.RFC3339))
+		}
+	} else {
+		qs.Set("QueryType", "SHIPMENT")
+	}
+
+	var shipments []InboundShipmentInfo
+	for {
+		var resp struct {
+			ShipmentData []InboundShipmentInfo `json:"ShipmentData"`
+			NextToken    string                `json:"NextToken"`
+		}
+		if err := s.inboundV0Get(ctx, "fbaInbound.getShipments", "/shipments", qs, &resp); err != nil {
+			return nil, err
+		}
+		shipments = append(shipments, resp.ShipmentData...)
+		if resp.NextToken == "" {
+			return shipments, nil
+		}
+
+		qs = url.Values{}
+		qs.Set("MarketplaceId", s.Marketplace.ID)
+		qs.Set("QueryType", "NEXT_TOKEN")
+		qs.Set("NextToken", resp.NextToken)
+	}
+}
+
+func (s *Client) paginateShipmentItems(ctx context.Context, operation, path string, qs url.Values) ([]InboundShipmentItem, error) {
+	var items []InboundShipmentItem
+	for {
+		var resp struct {
+			ItemData  []InboundShipmentItem `json:"ItemData"`
+			NextToken string                `json:"NextToken"`
+		}
+		if err := s.inboundV0Get(ctx, operation, path, qs, &resp); err != nil {
+			return nil, err
+		}
+		items = append(items, resp.ItemData...)
+		if resp.NextToken == "" {
+			return items, nil
+		}
+
+		qs = url.Values{}
+		qs.Set("MarketplaceId", s.Marketplace.ID)
+		qs.Set("QueryType", "NEXT_TOKEN")
+		qs.Set("NextToken", resp.NextToken)
+	}
+}
+
+func (s *Client) GetShipmentItemsByShipmentID(ctx context.Context, shipmentID string) ([]InboundShipmentItem, error) {
+	qs := url.Values{}
+	qs.Set("MarketplaceId", s.Marketplace.ID)
+	path := "/shipments/" + url.PathEscape(shipmentID) + "/items"
+	return s.paginateShipmentItems(ctx, "fbaInbound.getShipmentItemsByShipmentId", path, qs)
+}
+
+// GetShipmentItems returns items of all shipments updated in the window.
+func (s *Client) GetShipmentItems(ctx context.Context, lastUpdatedAfter, lastUpdatedBefore time.Time) ([]InboundShipmentItem, error) {
+	qs := url.Values{}
+	qs.Set("MarketplaceId", s.Marketplace.ID)
+	qs.Set("QueryType", "DATE_RANGE")
+	qs.Set("LastUpdatedAfter", lastUpdatedAfter.Format(time.RFC3339))
+	qs.Set("LastUpdatedBefore", lastUpdatedBefore.Format(time.RFC3339))
+	return s.paginateShipmentItems(ctx, "fbaInbound.getShipmentItems", "/shipmentItems", qs)
+}
+
+type LabelType string
+
+var (
+	LabelTypeBarcode2D LabelType = "BARCODE_2D"
+	LabelTypeUnique    LabelType = "UNIQUE"
+	LabelTypePallet    LabelType = "PALLET"
+)
+
+// GetLabelsRequest describes the labels to print. PageType is e.g.
+// PackageLabel_Letter_6 or PackageLabel_Thermal. UNIQUE labels need
+// PackageLabelsToPrint; PALLET labels need NumberOfPallets.
+type GetLabelsRequest struct {
+	PageType             string
+	LabelType            LabelType
+	NumberOfPackages     int
+	PackageLabelsToPrint []string
+	NumberOfPallets      int
+	PageSize             int
+	PageStartIndex       int
+}
+
+// GetLabels returns a URL to download the shipment's labels as a PDF.
+func (s *Client) GetLabels(ctx context.Context, shipmentID string, opts GetLabelsRequest) (string, error) {
+	qs := url.Values{}
+	qs.Set("PageType", opts.PageType)
+	qs.Set("LabelType", string(opts.LabelType))
+	if opts.NumberOfPackages > 0 {
+		qs.Set("NumberOfPackages", strconv.Itoa(opts.NumberOfPackages))
+	}
+	if len(opts.PackageLabelsToPrint) > 0 {
+		qs.Set("PackageLabelsToPrint", strings.Join(opts.PackageLabelsToPrint, ","))
+	}
+	if opts.NumberOfPallets > 0 {
+		qs.Set("NumberOfPallets", strconv.Itoa(opts.NumberOfPallets))
+	}
+	if opts.PageSize > 0 {
+		qs.Set("PageSize", strconv.Itoa(opts.PageSize))
+		qs.Set("PageStartIndex", strconv.Itoa(opts.PageStartIndex))
+	}
+
+	var resp struct {
+		DownloadURL string `json:"DownloadURL"`
+	}
+	path := "/shipments/" + url.PathEscape(shipmentID) + "/labels"
+	if err := s.inboundV0Get(ctx, "fbaInbound.getLabels", path, qs, &resp); err != nil {
+		return "", err
+	}
+	return resp.DownloadURL, nil
+}
+
+// GetBillOfLading returns a URL to download the bill of lading of a
+// partnered LTL shipment.
+func (s *Client) GetBillOfLading(ctx context.Context, shipmentID string) (string, error) {
+	var resp struct {
+		DownloadURL string `json:"DownloadURL"`
+	}
+	path := "/shipments/" + url.PathEscape(shipmentID) + "/billOfLading"
+	if err := s.inboundV0Get(ctx, "fbaInbound.getBillOfLading", path, url.Values{}, &resp); err != nil {
+		return "", err
+	}
+	return resp.DownloadURL, nil
+}
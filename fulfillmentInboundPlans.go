@@ -0,0 +1,707 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type InboundAddress struct {
+	Name                string `json:"name"`
+	CompanyName         string `json:"companyName,omitempty"`
+	AddressLine1        string `json:"addressLine1"`
+	AddressLine2        string `json:"addressLine2,omitempty"`
+	City                string `json:"city"`
+	StateOrProvinceCode string `json:"stateOrProvinceCode,omitempty"`
+	PostalCode          string `json:"postalCode"`
+	CountryCode         string `json:"countryCode"`
+	Email               string `json:"email,omitempty"`
+	PhoneNumber         string `json:"phoneNumber"`
+}
+
+type InboundItem struct {
+	MSKU                 string `json:"msku"`
+	PrepOwner            string `json:"prepOwner"`  // AMAZON, SELLER, NONE
+	LabelOwner           string `json:"labelOwner"` // AMAZON, SELLER, NONE
+	Quantity             int    `json:"quantity"`
+	Expiration           string `json:"expiration,omitempty"` // YYYY-MM-DD
+	ManufacturingLotCode string `json:"manufacturingLotCode,omitempty"`
+}
+
+type CreateInboundPlanRequest struct {
+	Name                    string         `json:"name,omitempty"`
+	DestinationMarketplaces []string       `json:"destinationMarketplaces"`
+	SourceAddress           InboundAddress `json:"sourceAddress"`
+	Items                   []InboundItem  `json:"items"`
+}
+
+type InboundPlanSummary struct {
+	PackingOptions []struct {
+		PackingOptionID string `json:"packingOptionId"`
+		Status          string `json:"status"`
+	} `json:"packingOptions"`
+	PlacementOptions []struct {
+		PlacementOptionID string `json:"placementOptionId"`
+		Status            string `json:"status"`
+	} `json:"placementOptions"`
+	Shipments []struct {
+		ShipmentID string `json:"shipmentId"`
+		Status     string `json:"status"`
+	} `json:"shipments"`
+}
+
+type InboundPlan struct {
+	InboundPlanID  string         `json:"inboundPlanId"`
+	Name           string         `json:"name"`
+	Status         string         `json:"status"` // ACTIVE, VOIDED, SHIPPED
+	SourceAddress  InboundAddress `json:"sourceAddress"`
+	MarketplaceIDs []string       `json:"marketplaceIds"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	LastUpdatedAt  time.Time      `json:"lastUpdatedAt"`
+	InboundPlanSummary
+}
+
+// inboundPlanMoney is the {code, amount} form Fulfillment Inbound 2024-03-20
+// uses for amounts.
+type inboundPlanMoney struct {
+	Code   string  `json:"code"`
+	Amount Decimal `json:"amount"`
+}
+
+func (m *inboundPlanMoney) money() *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{CurrencyCode: m.Code, Amount: m.Amount}
+}
+
+// Incentive is a fee or discount attached to an option.
+type Incentive struct {
+	Description string `json:"description"`
+	Target      string `json:"target"`
+	Type        string `json:"type"` // FEE, DISCOUNT
+	Value       Money  `json:"value"`
+}
+
+func (i *Incentive) UnmarshalJSON(b []byte) error {
+	type alias Incentive
+	var v struct {
+		*alias
+		Value inboundPlanMoney `json:"value"`
+	}
+	v.alias = (*alias)(i)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	i.Value = *v.Value.money()
+	return nil
+}
+
+type ShippingConfiguration struct {
+	ShippingMode     string `json:"shippingMode"`     // GROUND_SMALL_PARCEL, FREIGHT_LTL, ...
+	ShippingSolution string `json:"shippingSolution"` // AMAZON_PARTNERED_CARRIER, USE_YOUR_OWN_CARRIER
+}
+
+type PackingOption struct {
+	PackingOptionID                 string                  `json:"packingOptionId"`
+	PackingGroups                   []string                `json:"packingGroups"`
+	Fees                            []Incentive             `json:"fees"`
+	Discounts                       []Incentive             `json:"discounts"`
+	Expiration                      time.Time               `json:"expiration"`
+	Status                          string                  `json:"status"` // OFFERED, ACCEPTED, EXPIRED
+	SupportedShippingConfigurations []ShippingConfiguration `json:"supportedShippingConfigurations"`
+}
+
+type BoxDimensions struct {
+	Length            float64 `json:"length"`
+	Width             float64 `json:"width"`
+	Height            float64 `json:"height"`
+	UnitOfMeasurement string  `json:"unitOfMeasurement"` // IN, CM
+}
+
+type BoxWeight struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"` // LB, KG
+}
+
+type BoxInput struct {
+	ContentInformationSource string        `json:"contentInformationSource"` // BOX_CONTENT_PROVIDED, MANUAL_PROCESS, BARCODE_2D
+	Dimensions               BoxDimensions `json:"dimensions"`
+	Weight                   BoxWeight     `json:"weight"`
+	Quantity                 int           `json:"quantity"`
+	Items                    []InboundItem `json:"items,omitempty"`
+}
+
+type PackageGrouping struct {
+	PackingGroupID string     `json:"packingGroupId,omitempty"`
+	ShipmentID     string     `json:"shipmentId,omitempty"`
+	Boxes          []BoxInput `json:"boxes"`
+}
+
+type PlacementOption struct {
+	PlacementOptionID string      `json:"placementOptionId"`
+	ShipmentIDs       []string    `json:"shipmentIds"`
+	Fees              []Incentive `json:"fees"`
+	Discounts         []Incentive `json:"discounts"`
+	Expiration        time.Time   `json:"expiration"`
+	Status            string      `json:"status"` // OFFERED, ACCEPTED, EXPIRED
+}
+
+type InboundShipmentLocation struct {
+	Address     *InboundAddress `json:"address"`
+	WarehouseID string          `json:"warehouseId,omitempty"`
+}
+
+type InboundPlanShipment struct {
+	ShipmentID                     string                  `json:"shipmentId"`
+	Name                           string                  `json:"name"`
+	PlacementOptionID              string                  `json:"placementOptionId"`
+	Status                         string                  `json:"status"`
+	ShipmentConfirmationID         string                  `json:"shipmentConfirmationId"`
+	AmazonReferenceID              string                  `json:"amazonReferenceId"`
+	SelectedTransportationOptionID string                  `json:"selectedTransportationOptionId"`
+	Source                         InboundShipmentLocation `json:"source"`
+	Destination                    InboundShipmentLocation `json:"destination"`
+	SelectedDeliveryWindow         *struct {
+		AvailabilityType string    `json:"availabilityType"`
+		StartDate        time.Time `json:"startDate"`
+		EndDate          time.Time `json:"endDate"`
+	} `json:"selectedDeliveryWindow"`
+}
+
+type Carrier struct {
+	Name      string `json:"name"`
+	AlphaCode string `json:"alphaCode"`
+}
+
+type TransportationQuote struct {
+	Cost          Money     `json:"cost"`
+	Expiration    time.Time `json:"expiration"`
+	VoidableUntil time.Time `json:"voidableUntil"`
+}
+
+func (t *TransportationQuote) UnmarshalJSON(b []byte) error {
+	type alias TransportationQuote
+	var v struct {
+		*alias
+		Cost inboundPlanMoney `json:"cost"`
+	}
+	v.alias = (*alias)(t)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	t.Cost = *v.Cost.money()
+	return nil
+}
+
+type TransportationOption struct {
+	TransportationOptionID string               `json:"transportationOptionId"`
+	ShipmentID             string               `json:"shipmentId"`
+	ShippingMode           string               `json:"shippingMode"`
+	ShippingSolution       string               `json:"shippingSolution"`
+	Carrier                Carrier              `json:"carrier"`
+	Preconditions          []string             `json:"preconditions"`
+	Quote                  *TransportationQuote `json:"quote"`
+}
+
+type ContactInformation struct {
+	Name        string `json:"name"`
+	Email       string `json:"email,omitempty"`
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+type ReadyToShipWindow struct {
+	Start time.Time `json:"start"`
+}
+
+type ShipmentTransportationConfiguration struct {
+	ShipmentID         string              `json:"shipmentId"`
+	ReadyToShipWindow  ReadyToShipWindow   `json:"readyToShipWindow"`
+	ContactInformation *ContactInformation `json:"contactInformation,omitempty"`
+}
+
+type TransportationSelection struct {
+	ShipmentID             string              `json:"shipmentId"`
+	TransportationOptionID string              `json:"transportationOptionId"`
+	ContactInformation     *ContactInformation `json:"contactInformation,omitempty"`
+}
+
+type DeliveryWindowOption struct {
+	DeliveryWindowOptionID string    `json:"deliveryWindowOptionId"`
+	AvailabilityType       string    `json:"availabilityType"`
+	StartDate              time.Time `json:"startDate"`
+	EndDate                time.Time `json:"endDate"`
+	ValidUntil             time.Time `json:"validUntil"`
+}
+
+type MSKUQuantity struct {
+	MSKU     string `json:"msku"`
+	Quantity int    `json:"quantity"`
+}
+
+type CreateItemLabelsRequest struct {
+	LabelType      string         `json:"labelType"` // STANDARD_FORMAT, THERMAL_PRINTING
+	MarketplaceID  string         `json:"marketplaceId"`
+	MSKUQuantities []MSKUQuantity `json:"mskuQuantities"`
+	PageType       string         `json:"pageType,omitempty"`
+	LocaleCode     string         `json:"localeCode,omitempty"`
+	Width          float64        `json:"width,omitempty"`
+	Height         float64        `json:"height,omitempty"`
+}
+
+type DocumentDownload struct {
+	DownloadType string    `json:"downloadType"`
+	Expiration   time.Time `json:"expiration"`
+	URI          string    `json:"uri"`
+}
+
+type OperationProblem struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Details  string `json:"details"`
+	Severity string `json:"severity"` // ERROR, WARNING
+}
+
+type InboundOperationStatus struct {
+	OperationID       string             `json:"operationId"`
+	Operation         string             `json:"operation"`
+	OperationStatus   string             `json:"operationStatus"` // IN_PROGRESS, SUCCESS, FAILED
+	OperationProblems []OperationProblem `json:"operationProblems"`
+}
+
+// InboundOperationError is returned when an inbound operation fails.
+type InboundOperationError struct {
+	OperationID string
+	Operation   string
+	Problems    []OperationProblem
+}
+
+func (e *InboundOperationError) Error() string {
+	msgs := make([]string, 0, len(e.Problems))
+	for _, p := range e.Problems {
+		msgs = append(msgs, p.Code+": "+p.Message)
+	}
+	return fmt.Sprintf("inbound operation %s (%s) failed: %s", e.Operation, e.OperationID, strings.Join(msgs, "; "))
+}
+
+func (s *Client) inboundRequest(ctx context.Context, operation, method, path string, qs url.Values, body, out any) error {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		RawQuery: qs.Encode(),
+	}
+	setEscapedPath(&u, "/inbound/fba/2024-03-20"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		req.Body = b
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// inboundOperation sends a request that returns an operationId.
+func (s *Client) inboundOperation(ctx context.Context, operation, method, path string, body any) (string, error) {
+	var resp struct {
+		OperationID string `json:"operationId"`
+	}
+	if err := s.inboundRequest(ctx, operation, method, path, nil, body, &resp); err != nil {
+		return "", err
+	}
+	return resp.OperationID, nil
+}
+
+// paginateInbound follows pagination.nextToken, decoding field from each
+// page.
+func paginateInbound[T any](ctx context.Context, s *Client, operation, path string, qs url.Values, field string) ([]T, error) {
+	if qs == nil {
+		qs = url.Values{}
+	}
+	qs.Set("pageSize", "20")
+
+	var all []T
+	for {
+		var resp map[string]json.RawMessage
+		if err := s.inboundRequest(ctx, operation, http.MethodGet, path, qs, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		var page []T
+		if raw, ok := resp[field]; ok {
+			if err := json.Unmarshal(raw, &page); err != nil {
+				return nil, fmt.Errorf("error decoding spapi response: %w", err)
+			}
+		}
+		all = append(all, page...)
+
+		var pagination struct {
+			NextToken string `json:"nextToken"`
+		}
+		if raw, ok := resp["pagination"]; ok {
+			if err := json.Unmarshal(raw, &pagination); err != nil {
+				return nil, fmt.Errorf("error decoding spapi response: %w", err)
+			}
+		}
+		if pagination.NextToken == "" {
+			return all, nil
+		}
+		qs.Set("paginationToken", pagination.NextToken)
+	}
+}
+
+func inboundPlanPath(planID string, parts ...string) string {
+	p := "/inboundPlans/" + url.PathEscape(planID)
+	for _, part := range parts {
+		p += "/" + part
+	}
+	return p
+}
+
+// CreateInboundPlan returns the new plan ID and the operation creating it.
+func (s *Client) CreateInboundPlan(ctx context.Context, req CreateInboundPlanRequest) (planID, operationID string, err error) {
+	var resp struct {
+		InboundPlanID string `json:"inboundPlanId"`
+		OperationID   string `json:"operationId"`
+	}
+	if err := s.inboundRequest(ctx, "fbaInbound.createInboundPlan", http.MethodPost, "/inboundPlans", nil, req, &resp); err != nil {
+		return "", "", err
+	}
+	return resp.InboundPlanID, resp.OperationID, nil
+}
+
+func (s *Client) GetInboundPlan(ctx context.Context, planID string) (*InboundPlan, error) {
+	var resp InboundPlan
+	if err := s.inboundRequest(ctx, "fbaInbound.getInboundPlan", http.MethodGet, inboundPlanPath(planID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) CancelInboundPlan(ctx context.Context, planID string) (string, error) {
+	return s.inboundOperation(ctx, "fbaInbound.cancelInboundPlan", http.MethodPut, inboundPlanPath(planID, "cancellation"), nil)
+}
+
+func (s *Client) GeneratePackingOptions(ctx context.Context, planID string) (string, error) {
+	return s.inboundOperation(ctx, "fbaInbound.generatePackingOptions", http.MethodPost, inboundPlanPath(planID, "packingOptions"), nil)
+}
+
+func (s *Client) ListPackingOptions(ctx context.Context, planID string) ([]PackingOption, error) {
+	return paginateInbound[PackingOption](ctx, s, "fbaInbound.listPackingOptions", inboundPlanPath(planID, "packingOptions"), nil, "packingOptions")
+}
+
+func (s *Client) ListPackingGroupItems(ctx context.Context, planID, packingGroupID string) ([]InboundItem, error) {
+	path := inboundPlanPath(planID, "packingGroups", url.PathEscape(packingGroupID), "items")
+	return paginateInbound[InboundItem](ctx, s, "fbaInbound.listPackingGroupItems", path, nil, "items")
+}
+
+func (s *Client) ConfirmPackingOption(ctx context.Context, planID, packingOptionID string) (string, error) {
+	path := inboundPlanPath(planID, "packingOptions", url.PathEscape(packingOptionID), "confirmation")
+	return s.inboundOperation(ctx, "fbaInbound.confirmPackingOption", http.MethodPost, path, nil)
+}
+
+// SetPackingInformation provides box contents per packing group.
+func (s *Client) SetPackingInformation(ctx context.Context, planID string, groupings []PackageGrouping) (string, error) {
+	body := struct {
+		PackageGroupings []PackageGrouping `json:"packageGroupings"`
+	}{PackageGroupings: groupings}
+	return s.inboundOperation(ctx, "fbaInbound.setPackingInformation", http.MethodPost, inboundPlanPath(planID, "packingInformation"), body)
+}
+
+func (s *Client) GeneratePlacementOptions(ctx context.Context, planID string) (string, error) {
+	body := struct{}{}
+	return s.inboundOperation(ctx, "fbaInbound.generatePlacementOptions", http.MethodPost, inboundPlanPath(planID, "placementOptions"), body)
+}
+
+func (s *Client) ListPlacementOptions(ctx context.Context, planID string) ([]PlacementOption, error) {
+	return paginateInbound[PlacementOption](ctx, s, "fbaInbound.listPlacementOptions", inboundPlanPath(planID, "placementOptions"), nil, "placementOptions")
+}
+
+func (s *Client) ConfirmPlacementOption(ctx context.Context, planID, placementOptionID string) (string, error) {
+	path := inboundPlanPath(planID, "placementOptions", url.PathEscape(placementOptionID), "confirmation")
+	return s.inboundOperation(ctx, "fbaInbound.confirmPlacementOption", http.MethodPost, path, nil)
+}
+
+func (s *Client) GetInboundPlanShipment(ctx context.Context, planID, shipmentID string) (*InboundPlanShipment, error) {
+	var resp InboundPlanShipment
+	path := inboundPlanPath(planID, "shipments", url.PathEscape(shipmentID))
+	if err := s.inboundRequest(ctx, "fbaInbound.getShipment", http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) GenerateTransportationOptions(ctx context.Context, planID, placementOptionID string, configs []ShipmentTransportationConfiguration) (string, error) {
+	body := struct {
+		PlacementOptionID                    string                                `json:"placementOptionId"`
+		ShipmentTransportationConfigurations []ShipmentTransportationConfiguration `json:"shipmentTransportationConfigurations"`
+	}{PlacementOptionID: placementOptionID, ShipmentTransportationConfigurations: configs}
+	return s.inboundOperation(ctx, "fbaInbound.generateTransportationOptions", http.MethodPost, inboundPlanPath(planID, "transportationOptions"), body)
+}
+
+// ListTransportationOptions filters by placementOptionID or shipmentID;
+// either may be empty.
+func (s *Client) ListTransportationOptions(ctx context.Context, planID, placementOptionID, shipmentID string) ([]TransportationOption, error) {
+	qs := url.Values{}
+	if placementOptionID != "" {
+		qs.Set("placementOptionId", placementOptionID)
+	}
+	if shipmentID != "" {
+		qs.Set("shipmentId", shipmentID)
+	}
+	return paginateInbound[TransportationOption](ctx, s, "fbaInbound.listTransportationOptions", inboundPlanPath(planID, "transportationOptions"), qs, "transportationOptions")
+}
+
+func (s *Client) ConfirmTransportationOptions(ctx context.Context, planID string, selections []TransportationSelection) (string, error) {
+	body := struct {
+		TransportationSelections []TransportationSelection `json:"transportationSelections"`
+	}{TransportationSelections: selections}
+	return s.inboundOperation(ctx, "fbaInbound.confirmTransportationOptions", http.MethodPost, inboundPlanPath(planID, "transportationOptions", "confirmation"), body)
+}
+
+func (s *Client) GenerateDeliveryWindowOptions(ctx context.Context, planID, shipmentID string) (string, error) {
+	path := inboundPlanPath(planID, "shipments", url.PathEscape(shipmentID), "deliveryWindowOptions")
+	return s.inboundOperation(ctx, "fbaInbound.generateDeliveryWindowOptions", http.MethodPost, path, nil)
+}
+
+func (s *Client) ListDeliveryWindowOptions(ctx context.Context, planID, shipmentID string) ([]DeliveryWindowOption, error) {
+	path := inboundPlanPath(planID, "shipments", url.PathEscape(shipmentID), "deliveryWindowOptions")
+	return paginateInbound[DeliveryWindowOption](ctx, s, "fbaInbound.listDeliveryWindowOptions", path, nil, "deliveryWindowOptions")
+}
+
+func (s *Client) ConfirmDeliveryWindowOption(ctx context.Context, planID, shipmentID, deliveryWindowOptionID string) (string, error) {
+	path := inboundPlanPath(planID, "shipments", url.PathEscape(shipmentID), "deliveryWindowOptions", url.PathEscape(deliveryWindowOptionID), "confirmation")
+	return s.inboundOperation(ctx, "fbaInbound.confirmDeliveryWindowOptions", http.MethodPost, path, nil)
+}
+
+// CreateMarketplaceItemLabels returns download links for item (FNSKU)
+// labels.
+func (s *Client) CreateMarketplaceItemLabels(ctx context.Context, req CreateItemLabelsRequest) ([]DocumentDownload, error) {
+	if req.MarketplaceID == "" {
+		req.MarketplaceID = s.Marketplace.ID
+	}
+
+	var resp struct {
+		DocumentDownloads []DocumentDownload `json:"documentDownloads"`
+	}
+	if err := s.inboundRequest(ctx, "fbaInbound.createMarketplaceItemLabels", http.MethodPost, "/items/labels", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.DocumentDownloads, nil
+}
+
+func (s *Client) GetInboundOperationStatus(ctx context.Context, operationID string) (*InboundOperationStatus, error) {
+	var resp InboundOperationStatus
+	if err := s.inboundRequest(ctx, "fbaInbound.getInboundOperationStatus", http.MethodGet, "/operations/"+url.PathEscape(operationID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WaitForInboundOperation polls until the operation leaves IN_PROGRESS. A
+// FAILED operation is returned as an *InboundOperationError.
+func (s *Client) WaitForInboundOperation(ctx context.Context, operationID string, interval time.Duration) (*InboundOperationStatus, error) {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	for {
+		status, err := s.GetInboundOperationStatus(ctx, operationID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch status.OperationStatus {
+		case "SUCCESS":
+			for _, p := range status.OperationProblems {
+				s.logger().LogAttrs(ctx, slog.LevelWarn, "inbound operation problem",
+					slog.String("operation", status.Operation),
+					slog.String("operation_id", operationID),
+					slog.String("code", p.Code),
+					slog.String("message", p.Message),
+				)
+			}
+			return status, nil
+		case "FAILED":
+			return status, &InboundOperationError{
+				OperationID: operationID,
+				Operation:   status.Operation,
+				Problems:    status.OperationProblems,
+			}
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// InboundPlanWorkflow walks an inbound plan through the 2024-03-20 steps:
+// packing options, packing information, placement, transportation and
+// delivery windows. Each step starts the operation and waits for it, so a
+// failed step returns an *InboundOperationError.
+type InboundPlanWorkflow struct {
+	Client       *Client
+	PlanID       string
+	PollInterval time.Duration
+}
+
+// NewInboundPlanWorkflow creates a plan and waits until it is ready.
+func (s *Client) NewInboundPlanWorkflow(ctx context.Context, req CreateInboundPlanRequest) (*InboundPlanWorkflow, error) {
+	planID, opID, err := s.CreateInboundPlan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &InboundPlanWorkflow{Client: s, PlanID: planID}
+	if err := w.Wait(ctx, opID); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// ResumeInboundPlanWorkflow continues an existing plan.
+func (s *Client) ResumeInboundPlanWorkflow(planID string) *InboundPlanWorkflow {
+	return &InboundPlanWorkflow{Client: s, PlanID: planID}
+}
+
+// Wait polls operationID until it finishes.
+func (w *InboundPlanWorkflow) Wait(ctx context.Context, operationID string) error {
+	_, err := w.Client.WaitForInboundOperation(ctx, operationID, w.PollInterval)
+	return err
+}
+
+func (w *InboundPlanWorkflow) run(ctx context.Context, operationID string, err error) error {
+	if err != nil {
+		return err
+	}
+	return w.Wait(ctx, operationID)
+}
+
+func (w *InboundPlanWorkflow) Plan(ctx context.Context) (*InboundPlan, error) {
+	return w.Client.GetInboundPlan(ctx, w.PlanID)
+}
+
+// PackingOptions generates and lists the packing options.
+func (w *InboundPlanWorkflow) PackingOptions(ctx context.Context) ([]PackingOption, error) {
+	opID, err := w.Client.GeneratePackingOptions(ctx, w.PlanID)
+	if err := w.run(ctx, opID, err); err != nil {
+		return nil, err
+	}
+	return w.Client.ListPackingOptions(ctx, w.PlanID)
+}
+
+func (w *InboundPlanWorkflow) ConfirmPackingOption(ctx context.Context, packingOptionID string) error {
+	opID, err := w.Client.ConfirmPackingOption(ctx, w.PlanID, packingOptionID)
+	return w.run(ctx, opID, err)
+}
+
+func (w *InboundPlanWorkflow) SetPackingInformation(ctx context.Context, groupings []PackageGrouping) error {
+	opID, err := w.Client.SetPackingInformation(ctx, w.PlanID, groupings)
+	return w.run(ctx, opID, err)
+}
+
+// PlacementOptions generates and lists the placement options.
+func (w *InboundPlanWorkflow) PlacementOptions(ctx context.Context) ([]PlacementOption, error) {
+	opID, err := w.Client.GeneratePlacementOptions(ctx, w.PlanID)
+	if err := w.run(ctx, opID, err); err != nil {
+		return nil, err
+	}
+	return w.Client.ListPlacementOptions(ctx, w.PlanID)
+}
+
+func (w *InboundPlanWorkflow) ConfirmPlacementOption(ctx context.Context, placementOptionID string) error {
+	opID, err := w.Client.ConfirmPlacementOption(ctx, w.PlanID, placementOptionID)
+	return w.run(ctx, opID, err)
+}
+
+// TransportationOptions generates and lists the transportation options for
+// the shipments of placementOptionID.
+func (w *InboundPlanWorkflow) TransportationOptions(ctx context.Context, placementOptionID string, configs []ShipmentTransportationConfiguration) ([]TransportationOption, error) {
+	opID, err := w.Client.GenerateTransportationOptions(ctx, w.PlanID, placementOptionID, configs)
+	if err := w.run(ctx, opID, err); err != nil {
+		return nil, err
+	}
+	return w.Client.ListTransportationOptions(ctx, w.PlanID, placementOptionID, "")
+}
+
+func (w *InboundPlanWorkflow) ConfirmTransportationOptions(ctx context.Context, selections []TransportationSelection) error {
+	opID, err := w.Client.ConfirmTransportationOptions(ctx, w.PlanID, selections)
+	return w.run(ctx, opID, err)
+}
+
+// DeliveryWindowOptions generates and lists delivery windows for shipmentID.
+func (w *InboundPlanWorkflow) DeliveryWindowOptions(ctx context.Context, shipmentID string) ([]DeliveryWindowOption, error) {
+	opID, err := w.Client.GenerateDeliveryWindowOptions(ctx, w.PlanID, shipmentID)
+	if err := w.run(ctx, opID, err); err != nil {
+		return nil, err
+	}
+	return w.Client.ListDeliveryWindowOptions(ctx, w.PlanID, shipmentID)
+}
+
+func (w *InboundPlanWorkflow) ConfirmDeliveryWindowOption(ctx context.Context, shipmentID, deliveryWindowOptionID string) error {
+	opID, err := w.Client.ConfirmDeliveryWindowOption(ctx, w.PlanID, shipmentID, deliveryWindowOptionID)
+	return w.run(ctx, opID, err)
+}
+
+// Shipments returns the plan's shipments once placement is confirmed.
+func (w *InboundPlanWorkflow) Shipments(ctx context.Context) ([]InboundPlanShipment, error) {
+	plan, err := w.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shipments := make([]InboundPlanShipment, 0, len(plan.Shipments))
+	for _, sh := range plan.Shipments {
+		s, err := w.Client.GetInboundPlanShipment(ctx, w.PlanID, sh.ShipmentID)
+		if err != nil {
+			return nil, err
+		}
+		shipments = append(shipments, *s)
+	}
+	return shipments, nil
+}
+
+// ItemLabels requests labels for the plan's items in its first destination
+// marketplace.
+func (w *InboundPlanWorkflow) ItemLabels(ctx context.Context, labelType string, quantities map[string]int) ([]DocumentDownload, error) {
+	plan, err := w.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := CreateItemLabelsRequest{LabelType: labelType}
+	if len(plan.MarketplaceIDs) > 0 {
+		req.MarketplaceID = plan.MarketplaceIDs[0]
+	}
+	for msku, qty := range quantities {
+		req.MSKUQuantities = append(req.MSKUQuantities, MSKUQuantity{MSKU: msku, Quantity: qty})
+	}
+	return w.Client.CreateMarketplaceItemLabels(ctx, req)
+}
@@ -0,0 +1,684 @@
+package spapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type ShippingSpeedCategory string
+
+var (
+	ShippingSpeedStandard          ShippingSpeedCategory = "Standard"
+	ShippingSpeedExpedited         ShippingSpeedCategory = "Expedited"
+	ShippingSpeedPriority          ShippingSpeedCategory = "Priority"
+	ShippingSpeedScheduledDelivery ShippingSpeedCategory = "ScheduledDelivery"
+)
+
+type FulfillmentAction string
+
+var (
+	FulfillmentActionShip FulfillmentAction = "Ship"
+	FulfillmentActionHold FulfillmentAction = "Hold"
+)
+
+type FulfillmentPolicy string
+
+var (
+	FulfillmentPolicyFillOrKill       FulfillmentPolicy = "FillOrKill"
+	FulfillmentPolicyFillAll          FulfillmentPolicy = "FillAll"
+	FulfillmentPolicyFillAllAvailable FulfillmentPolicy = "FillAllAvailable"
+)
+
+type FulfillmentAddress struct {
+	Name             string `json:"name"`
+	AddressLine1     string `json:"addressLine1"`
+	AddressLine2     string `json:"addressLine2,omitempty"`
+	AddressLine3     string `json:"addressLine3,omitempty"`
+	City             string `json:"city,omitempty"`
+	DistrictOrCounty string `json:"districtOrCounty,omitempty"`
+	StateOrRegion    string `json:"stateOrRegion"`
+	PostalCode       string `json:"postalCode"`
+	CountryCode      string `json:"countryCode"`
+	Phone            string `json:"phone,omitempty"`
+}
+
+// outboundMoney is the {currencyCode, value} form the outbound API uses for
+// amounts, with the value as a string.
+type outboundMoney struct {
+	CurrencyCode string  `json:"currencyCode"`
+	Value        Decimal `json:"value"`
+}
+
+func (m outboundMoney) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{"currencyCode": m.CurrencyCode, "value": m.Value.String()})
+}
+
+func (m *outboundMoney) money() *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{CurrencyCode: m.CurrencyCode, Amount: m.Value}
+}
+
+func toOutboundMoney(m *Money) *outboundMoney {
+	if m == nil {
+		return nil
+	}
+	return &outboundMoney{CurrencyCode: m.CurrencyCode, Value: m.Amount}
+}
+
+type FulfillmentOrderItemInput struct {
+	SellerSKU                    string `json:"sellerSku"`
+	SellerFulfillmentOrderItemID string `json:"sellerFulfillmentOrderItemId"`
+	Quantity                     int    `json:"quantity"`
+	GiftMessage                  string `json:"giftMessage,omitempty"`
+	DisplayableComment           string `json:"displayableComment,omitempty"`
+	FulfillmentNetworkSKU        string `json:"fulfillmentNetworkSku,omitempty"`
+	PerUnitDeclaredValue         *Money `json:"-"`
+	PerUnitPrice                 *Money `json:"-"`
+	PerUnitTax                   *Money `json:"-"`
+}
+
+func (i FulfillmentOrderItemInput) MarshalJSON() ([]byte, error) {
+	type alias FulfillmentOrderItemInput
+	return json.Marshal(struct {
+		alias
+		PerUnitDeclaredValue *outboundMoney `json:"perUnitDeclaredValue,omitempty"`
+		PerUnitPrice         *outboundMoney `json:"perUnitPrice,omitempty"`
+		PerUnitTax           *outboundMoney `json:"perUnitTax,omitempty"`
+	}{
+		alias:                alias(i),
+		PerUnitDeclaredValue: toOutboundMoney(i.PerUnitDeclaredValue),
+		PerUnitPrice:         toOutboundMoney(i.PerUnitPrice),
+		PerUnitTax:           toOutboundMoney(i.PerUnitTax),
+	})
+}
+
+type FulfillmentWeight struct {
+	Unit  string  `json:"unit"` // KG, KILOGRAMS, LB, POUNDS
+	Value Decimal `json:"value"`
+}
+
+type FulfillmentFee struct {
+	Name   string `json:"name"`
+	Amount Money  `json:"amount"`
+}
+
+func (f *FulfillmentFee) UnmarshalJSON(b []byte) error {
+	type alias FulfillmentFee
+	var v struct {
+		*alias
+		Amount outboundMoney `json:"amount"`
+	}
+	v.alias = (*alias)(f)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	f.Amount = *v.Amount.money()
+	return nil
+}
+
+type FulfillmentPreviewItem struct {
+	SellerSKU                       string             `json:"sellerSku"`
+	Quantity                        int                `json:"quantity"`
+	SellerFulfillmentOrderItemID    string             `json:"sellerFulfillmentOrderItemId"`
+	EstimatedShippingWeight         *FulfillmentWeight `json:"estimatedShippingWeight"`
+	ShippingWeightCalculationMethod string             `json:"shippingWeightCalculationMethod"`
+}
+
+type FulfillmentPreviewShipment struct {
+	EarliestShipDate        time.Time                `json:"earliestShipDate"`
+	LatestShipDate          time.Time                `json:"latestShipDate"`
+	EarliestArrivalDate     time.Time                `json:"earliestArrivalDate"`
+	LatestArrivalDate       time.Time                `json:"latestArrivalDate"`
+	ShippingNotes           []string                 `json:"shippingNotes"`
+	FulfillmentPreviewItems []FulfillmentPreviewItem `json:"fulfillmentPreviewItems"`
+}
+
+type UnfulfillablePreviewItem struct {
+	SellerSKU                    string   `json:"sellerSku"`
+	Quantity                     int      `json:"quantity"`
+	SellerFulfillmentOrderItemID string   `json:"sellerFulfillmentOrderItemId"`
+	ItemUnfulfillableReasons     []string `json:"itemUnfulfillableReasons"`
+}
+
+type FulfillmentPreview struct {
+	ShippingSpeedCategory       ShippingSpeedCategory        `json:"shippingSpeedCategory"`
+	IsFulfillable               bool                         `json:"isFulfillable"`
+	IsCODCapable                bool                         `json:"isCODCapable"`
+	EstimatedShippingWeight     *FulfillmentWeight           `json:"estimatedShippingWeight"`
+	EstimatedFees               []FulfillmentFee             `json:"estimatedFees"`
+	FulfillmentPreviewShipments []FulfillmentPreviewShipment `json:"fulfillmentPreviewShipments"`
+	UnfulfillablePreviewItems   []UnfulfillablePreviewItem   `json:"unfulfillablePreviewItems"`
+	OrderUnfulfillableReasons   []string                     `json:"orderUnfulfillableReasons"`
+	MarketplaceID               string                       `json:"marketplaceId"`
+}
+
+type GetFulfillmentPreviewRequest struct {
+	MarketplaceID                string                      `json:"marketplaceId,omitempty"`
+	Address                      FulfillmentAddress          `json:"address"`
+	Items                        []FulfillmentOrderItemInput `json:"items"`
+	ShippingSpeedCategories      []ShippingSpeedCategory     `json:"shippingSpeedCategories,omitempty"`
+	IncludeCODFulfillmentPreview bool                        `json:"includeCODFulfillmentPreview,omitempty"`
+	IncludeDeliveryWindows       bool                        `json:"includeDeliveryWindows,omitempty"`
+}
+
+type CreateFulfillmentOrderRequest struct {
+	MarketplaceID            string                      `json:"marketplaceId,omitempty"`
+	SellerFulfillmentOrderID string                      `json:"sellerFulfillmentOrderId"`
+	DisplayableOrderID       string                      `json:"displayableOrderId"`
+	DisplayableOrderDate     time.Time                   `json:"displayableOrderDate"`
+	DisplayableOrderComment  string                      `json:"displayableOrderComment"`
+	ShippingSpeedCategory    ShippingSpeedCategory       `json:"shippingSpeedCategory"`
+	DestinationAddress       FulfillmentAddress          `json:"destinationAddress"`
+	FulfillmentAction        FulfillmentAction           `json:"fulfillmentAction,omitempty"`
+	FulfillmentPolicy        FulfillmentPolicy           `json:"fulfillmentPolicy,omitempty"`
+	ShipFromCountryCode      string                      `json:"shipFromCountryCode,omitempty"`
+	NotificationEmails       []string                    `json:"notificationEmails,omitempty"`
+	Items                    []FulfillmentOrderItemInput `json:"items"`
+}
+
+// UpdateFulfillmentOrderRequest changes an order that has not shipped yet.
+// Zero fields are left unchanged; set FulfillmentAction to Ship to release
+// a held order.
+type UpdateFulfillmentOrderRequest struct {
+	MarketplaceID           string                      `json:"marketplaceId,omitempty"`
+	DisplayableOrderID      string                      `json:"displayableOrderId,omitempty"`
+	DisplayableOrderDate    *time.Time                  `json:"displayableOrderDate,omitempty"`
+	DisplayableOrderComment string                      `json:"displayableOrderComment,omitempty"`
+	ShippingSpeedCategory   ShippingSpeedCategory       `json:"shippingSpeedCategory,omitempty"`
+	DestinationAddress      *FulfillmentAddress         `json:"destinationAddress,omitempty"`
+	FulfillmentAction       FulfillmentAction           `json:"fulfillmentAction,omitempty"`
+	FulfillmentPolicy       FulfillmentPolicy           `json:"fulfillmentPolicy,omitempty"`
+	ShipFromCountryCode     string                      `json:"shipFromCountryCode,omitempty"`
+	NotificationEmails      []string                    `json:"notificationEmails,omitempty"`
+	Items                   []FulfillmentOrderItemInput `json:"items,omitempty"`
+}
+
+type FulfillmentOrder struct {
+	SellerFulfillmentOrderID string                `json:"sellerFulfillmentOrderId"`
+	MarketplaceID            string                `json:"marketplaceId"`
+	DisplayableOrderID       string                `json:"displayableOrderId"`
+	DisplayableOrderDate     time.Time             `json:"displayableOrderDate"`
+	DisplayableOrderComment  string                `json:"displayableOrderComment"`
+	ShippingSpeedCategory    ShippingSpeedCategory `json:"shippingSpeedCategory"`
+	DestinationAddress       FulfillmentAddress    `json:"destinationAddress"`
+	FulfillmentAction        FulfillmentAction     `json:"fulfillmentAction"`
+	FulfillmentPolicy        FulfillmentPolicy     `json:"fulfillmentPolicy"`
+	ReceivedDate             time.Time             `json:"receivedDate"`
+	FulfillmentOrderStatus   string                `json:"fulfillmentOrderStatus"` // New, Received, Planning, Processing, Cancelled, Complete, CompletePartialled, Unfulfillable, Invalid
+	StatusUpdatedDate        time.Time             `json:"statusUpdatedDate"`
+	NotificationEmails       []string              `json:"notificationEmails"`
+}
+
+type FulfillmentOrderItem struct {
+	SellerSKU                    string  `json:"sellerSku"`
+	SellerFulfillmentOrderItemID string  `json:"sellerFulfillmentOrderItemId"`
+	Quantity                     int     `json:"quantity"`
+	FulfillmentNetworkSKU        string  `json:"fulfillmentNetworkSku"`
+	OrderItemDisposition         string  `json:"orderItemDisposition"`
+	CancelledQuantity            int     `json:"cancelledQuantity"`
+	UnfulfillableQuantity        int     `json:"unfulfillableQuantity"`
+	EstimatedShipDate            *string `json:"estimatedShipDate"`
+	EstimatedArrivalDate         *string `json:"estimatedArrivalDate"`
+	PerUnitDeclaredValue         *Money  `json:"perUnitDeclaredValue"`
+	PerUnitPrice                 *Money  `json:"perUnitPrice"`
+	PerUnitTax                   *Money  `json:"perUnitTax"`
+}
+
+func (f *FulfillmentOrderItem) UnmarshalJSON(b []byte) error {
+	type alias FulfillmentOrderItem
+	var v struct {
+		*alias
+		PerUnitDeclaredValue *outboundMoney `json:"perUnitDeclaredValue"`
+		PerUnitPrice         *outboundMoney `json:"perUnitPrice"`
+		PerUnitTax           *outboundMoney `json:"perUnitTax"`
+	}
+	v.alias = (*alias)(f)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	f.PerUnitDeclaredValue = v.PerUnitDeclaredValue.money()
+	f.PerUnitPrice = v.PerUnitPrice.money()
+	f.PerUnitTax = v.PerUnitTax.money()
+	return nil
+}
+
+type FulfillmentShipmentPackage struct {
+	PackageNumber        int       `json:"packageNumber"`
+	CarrierCode          string    `json:"carrierCode"`
+	TrackingNumber       string    `json:"trackingNumber"`
+	EstimatedArrivalDate time.Time `json:"estimatedArrivalDate"`
+}
+
+type FulfillmentShipment struct {
+	AmazonShipmentID          string    `json:"amazonShipmentId"`
+	FulfillmentCenterID       string    `json:"fulfillmentCenterId"`
+	FulfillmentShipmentStatus string    `json:"fulfillmentShipmentStatus"` // PENDING, SHIPPED, CANCELLED_BY_FULFILLER, CANCELLED_BY_SELLER
+	ShippingDate              time.Time `json:"shippingDate"`
+	EstimatedArrivalDate      time.Time `json:"estimatedArrivalDate"`
+	ShippingNotes             []string  `json:"shippingNotes"`
+	FulfillmentShipmentItem   []struct {
+		SellerSKU                    string `json:"sellerSku"`
+		SellerFulfillmentOrderItemID string `json:"sellerFulfillmentOrderItemId"`
+		Quantity                     int    `json:"quantity"`
+		PackageNumber                int    `json:"packageNumber"`
+		SerialNumber                 string `json:"serialNumber"`
+	} `json:"fulfillmentShipmentItem"`
+	FulfillmentShipmentPackage []FulfillmentShipmentPackage `json:"fulfillmentShipmentPackage"`
+}
+
+type ReturnItem struct {
+	SellerReturnItemID           string `json:"sellerReturnItemId"`
+	SellerFulfillmentOrderItemID string `json:"sellerFulfillmentOrderItemId"`
+	AmazonShipmentID             string `json:"amazonShipmentId"`
+	SellerReturnReasonCode       string `json:"sellerReturnReasonCode"`
+	ReturnComment                string `json:"returnComment"`
+	AmazonReturnReasonCode       string `json:"amazonReturnReasonCode"`
+	Status                       string `json:"status"` // New, Processed
+	StatusChangedDate            string `json:"statusChangedDate"`
+	ReturnAuthorizationID        string `json:"returnAuthorizationId"`
+	ReturnReceivedCondition      string `json:"returnReceivedCondition"`
+	FulfillmentCenterID          string `json:"fulfillmentCenterId"`
+}
+
+type InvalidReturnItem struct {
+	SellerReturnItemID           string `json:"sellerReturnItemId"`
+	SellerFulfillmentOrderItemID string `json:"sellerFulfillmentOrderItemId"`
+	InvalidItemReason            struct {
+		InvalidItemReasonCode string `json:"invalidItemReasonCode"`
+		Description           string `json:"description"`
+	} `json:"invalidItemReason"`
+}
+
+type ReturnAuthorization struct {
+	ReturnAuthorizationID string             `json:"returnAuthorizationId"`
+	FulfillmentCenterID   string             `json:"fulfillmentCenterId"`
+	ReturnToAddress       FulfillmentAddress `json:"returnToAddress"`
+	AmazonRmaID           string             `json:"amazonRmaId"`
+	RMAPageURL            string             `json:"rmaPageURL"`
+}
+
+type FulfillmentOrderDetails struct {
+	FulfillmentOrder      FulfillmentOrder       `json:"fulfillmentOrder"`
+	FulfillmentOrderItems []FulfillmentOrderItem `json:"fulfillmentOrderItems"`
+	FulfillmentShipments  []FulfillmentShipment  `json:"fulfillmentShipments"`
+	ReturnItems           []ReturnItem           `json:"returnItems"`
+	ReturnAuthorizations  []ReturnAuthorization  `json:"returnAuthorizations"`
+}
+
+type TrackingAddress struct {
+	City    string `json:"city"`
+	State   string `json:"state"`
+	Country string `json:"country"`
+}
+
+type TrackingEvent struct {
+	EventDate        time.Time       `json:"eventDate"`
+	EventAddress     TrackingAddress `json:"eventAddress"`
+	EventCode        string          `json:"eventCode"`
+	EventDescription string          `json:"eventDescription"`
+}
+
+type PackageTrackingDetails struct {
+	PackageNumber            int             `json:"packageNumber"`
+	TrackingNumber           string          `json:"trackingNumber"`
+	CustomerTrackingLink     string          `json:"customerTrackingLink"`
+	CarrierCode              string          `json:"carrierCode"`
+	CarrierPhoneNumber       string          `json:"carrierPhoneNumber"`
+	CarrierURL               string          `json:"carrierURL"`
+	ShipDate                 time.Time       `json:"shipDate"`
+	EstimatedArrivalDate     time.Time       `json:"estimatedArrivalDate"`
+	ShipToAddress            TrackingAddress `json:"shipToAddress"`
+	CurrentStatus            string          `json:"currentStatus"`
+	CurrentStatusDescription string          `json:"currentStatusDescription"`
+	SignedForBy              string          `json:"signedForBy"`
+	AdditionalLocationInfo   string          `json:"additionalLocationInfo"`
+	TrackingEvents           []TrackingEvent `json:"trackingEvents"`
+}
+
+type CreateReturnItem struct {
+	SellerReturnItemID           string `json:"sellerReturnItemId"`
+	SellerFulfillmentOrderItemID string `json:"sellerFulfillmentOrderItemId"`
+	AmazonShipmentID             string `json:"amazonShipmentId"`
+	ReturnReasonCode             string `json:"returnReasonCode"`
+	ReturnComment                string `json:"returnComment,omitempty"`
+}
+
+type CreateFulfillmentReturnResult struct {
+	ReturnItems          []ReturnItem          `json:"returnItems"`
+	InvalidReturnItems   []InvalidReturnItem   `json:"invalidReturnItems"`
+	ReturnAuthorizations []ReturnAuthorization `json:"returnAuthorizations"`
+}
+
+type ReasonCodeDetails struct {
+	ReturnReasonCode      string `json:"returnReasonCode"`
+	Description           string `json:"description"`
+	TranslatedDescription string `json:"translatedDescription"`
+}
+
+type FulfillmentFeature struct {
+	FeatureName        string `json:"featureName"`
+	FeatureDescription string `json:"featureDescription"`
+	SellerEligible     bool   `json:"sellerEligible"`
+}
+
+type FeatureSKU struct {
+	SellerSKU       string   `json:"sellerSku"`
+	FnSKU           string   `json:"fnSku"`
+	ASIN            string   `json:"asin"`
+	SKUCount        float64  `json:"skuCount"`
+	OverlappingSKUs []string `json:"overlappingSkus"`
+}
+
+func (s *Client) outboundRequest(ctx context.Context, operation, method, path string, qs url.Values, body, out any) error {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		RawQuery: qs.Encode(),
+	}
+	setEscapedPath(&u, "/fba/outbound/2020-07-01"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		req.Body = b
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+
+	resp := struct {
+		Payload any `json:"payload"`
+	}{Payload: out}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+func fulfillmentOrderPath(id string, parts ...string) string {
+	p := "/fulfillmentOrders/" + url.PathEscape(id)
+	for _, part := range parts {
+		p += "/" + part
+	}
+	return p
+}
+
+// defaultItemIDs uses the SKU as the item ID when none is set, so a retried
+// request describes the same items.
+func defaultItemIDs(items []FulfillmentOrderItemInput) []FulfillmentOrderItemInput {
+	out := make([]FulfillmentOrderItemInput, len(items))
+	for i, item := range items {
+		if item.SellerFulfillmentOrderItemID == "" {
+			item.SellerFulfillmentOrderItemID = item.SellerSKU
+		}
+		out[i] = item
+	}
+	return out
+}
+
+func (s *Client) GetFulfillmentPreview(ctx context.Context, req GetFulfillmentPreviewRequest) ([]FulfillmentPreview, error) {
+	if req.MarketplaceID == "" {
+		req.MarketplaceID = s.Marketplace.ID
+	}
+	req.Items = defaultItemIDs(req.Items)
+
+	var resp struct {
+		FulfillmentPreviews []FulfillmentPreview `json:"fulfillmentPreviews"`
+	}
+	if err := s.outboundRequest(ctx, "fbaOutbound.getFulfillmentPreview", http.MethodPost, "/fulfillmentOrders/preview", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.FulfillmentPreviews, nil
+}
+
+var invalidFulfillmentOrderIDChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+const maxFulfillmentOrderIDLen = 40
+
+// FulfillmentOrderID derives a stable sellerFulfillmentOrderId from an
+// external order reference, e.g. FulfillmentOrderID("shopify", "#1001"), so
+// the same source order always maps to the same MCF order. When a part
+// contains the "-" separator, invalid characters are replaced or the ID is
+// cut to 40 characters, a hash of the raw source and ref is appended so that
+// distinct references stay distinct; otherwise "a-b"/"c" and "a"/"b-c" would
+// share an ID.
+func FulfillmentOrderID(source, ref string) string {
+	raw := source + "-" + ref
+	id := invalidFulfillmentOrderIDChars.ReplaceAllString(raw, "-")
+	if id == raw && len(id) <= maxFulfillmentOrderIDLen &&
+		!strings.Contains(source, "-") && !strings.Contains(ref, "-") {
+		return id
+	}
+
+	sum := sha256.Sum256([]byte(source + "\x00" + ref))
+	suffix := "-" + hex.EncodeToString(sum[:])[:12]
+	if len(id) > maxFulfillmentOrderIDLen-len(suffix) {
+		id = id[:maxFulfillmentOrderIDLen-len(suffix)]
+	}
+	return id + suffix
+}
+
+// ErrFulfillmentOrderExists is returned by CreateFulfillmentOrder when an
+// order with the same sellerFulfillmentOrderId already exists.
+var ErrFulfillmentOrderExists = errors.New("spapi: fulfillment order already exists")
+
+// ErrFulfillmentOrderIDConflict is returned by CreateFulfillmentOrder when
+// the sellerFulfillmentOrderId is taken by an order with a different
+// DisplayableOrderID, i.e. a different source order.
+var ErrFulfillmentOrderIDConflict = errors.New("spapi: fulfillment order ID used by a different order")
+
+// isDuplicateFulfillmentOrder reports whether a create failed because the
+// sellerFulfillmentOrderId is already in use. Only the DuplicateRequest code
+// counts; other validation errors are never treated as duplicates, whatever
+// their message says.
+func isDuplicateFulfillmentOrder(err Error) bool {
+	if err.StatusCode != http.StatusBadRequest && err.StatusCode != http.StatusConflict {
+		return false
+	}
+	for _, e := range err.Errors {
+		if e.Code == "DuplicateRequest" {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateFulfillmentOrder creates an MCF order. SellerFulfillmentOrderID is
+// required and acts as the idempotency key: if Amazon rejects the create as
+// a duplicate ID (e.g. a retried request that Amazon had accepted) and the
+// existing order has the same DisplayableOrderID, it is returned along with
+// ErrFulfillmentOrderExists. A different DisplayableOrderID returns
+// ErrFulfillmentOrderIDConflict.
+func (s *Client) CreateFulfillmentOrder(ctx context.Context, req CreateFulfillmentOrderRequest) (*FulfillmentOrderDetails, error) {
+	if req.SellerFulfillmentOrderID == "" {
+		return nil, fmt.Errorf("SellerFulfillmentOrderID is required")
+	}
+	if req.MarketplaceID == "" {
+		req.MarketplaceID = s.Marketplace.ID
+	}
+	if req.DisplayableOrderID == "" {
+		req.DisplayableOrderID = req.SellerFulfillmentOrderID
+	}
+	if req.DisplayableOrderDate.IsZero() {
+		req.DisplayableOrderDate = time.Now().UTC()
+	}
+	req.Items = defaultItemIDs(req.Items)
+
+	createErr := s.outboundRequest(ctx, "fbaOutbound.createFulfillmentOrder", http.MethodPost, "/fulfillmentOrders", nil, req, nil)
+	if createErr == nil {
+		return s.GetFulfillmentOrder(ctx, req.SellerFulfillmentOrderID)
+	}
+
+	var apiErr Error
+	if !errors.As(createErr, &apiErr) || !isDuplicateFulfillmentOrder(apiErr) {
+		return nil, createErr
+	}
+	existing, err := s.GetFulfillmentOrder(ctx, req.SellerFulfillmentOrderID)
+	if err != nil {
+		return nil, createErr
+	}
+	if existing.FulfillmentOrder.DisplayableOrderID != req.DisplayableOrderID {
+		return nil, fmt.Errorf("%w: %s belongs to displayable order %q", ErrFulfillmentOrderIDConflict, req.SellerFulfillmentOrderID, existing.FulfillmentOrder.DisplayableOrderID)
+	}
+	return existing, ErrFulfillmentOrderExists
+}
+
+func (s *Client) GetFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderID string) (*FulfillmentOrderDetails, error) {
+	var resp FulfillmentOrderDetails
+	if err := s.outboundRequest(ctx, "fbaOutbound.getFulfillmentOrder", http.MethodGet, fulfillmentOrderPath(sellerFulfillmentOrderID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllFulfillmentOrders returns orders updated after queryStartDate,
+// following nextToken. A zero queryStartDate uses Amazon's default of 36
+// hours ago.
+func (s *Client) ListAllFulfillmentOrders(ctx context.Context, queryStartDate time.Time) ([]FulfillmentOrder, error) {
+	qs := url.Values{}
+	if !queryStartDate.IsZero() {
+		qs.Set("queryStartDate", queryStartDate.Format(time.RFC3339))
+	}
+
+	var orders []FulfillmentOrder
+	for {
+		var resp struct {
+			NextToken         string             `json:"nextToken"`
+			FulfillmentOrders []FulfillmentOrder `json:"fulfillmentOrders"`
+		}
+		if err := s.outboundRequest(ctx, "fbaOutbound.listAllFulfillmentOrders", http.MethodGet, "/fulfillmentOrders", qs, nil, &resp); err != nil {
+			return nil, err
+		}
+		orders = append(orders, resp.FulfillmentOrders...)
+		if resp.NextToken == "" {
+			return orders, nil
+		}
+		qs = url.Values{}
+		qs.Set("nextToken", resp.NextToken)
+	}
+}
+
+func (s *Client) UpdateFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderID string, req UpdateFulfillmentOrderRequest) error {
+	if len(req.Items) > 0 {
+		req.Items = defaultItemIDs(req.Items)
+	}
+	return s.outboundRequest(ctx, "fbaOutbound.updateFulfillmentOrder", http.MethodPut, fulfillmentOrderPath(sellerFulfillmentOrderID), nil, req, nil)
+}
+
+func (s *Client) CancelFulfillmentOrder(ctx context.Context, sellerFulfillmentOrderID string) error {
+	return s.outboundRequest(ctx, "fbaOutbound.cancelFulfillmentOrder", http.MethodPut, fulfillmentOrderPath(sellerFulfillmentOrderID, "cancel"), nil, nil, nil)
+}
+
+// GetPackageTrackingDetails takes a packageNumber from
+// FulfillmentShipmentPackage.
+func (s *Client) GetPackageTrackingDetails(ctx context.Context, packageNumber int) (*PackageTrackingDetails, error) {
+	qs := url.Values{}
+	qs.Set("packageNumber", fmt.Sprint(packageNumber))
+
+	var resp PackageTrackingDetails
+	if err := s.outboundRequest(ctx, "fbaOutbound.getPackageTrackingDetails", http.MethodGet, "/tracking", qs, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateFulfillmentReturn requests returns for shipped items. Each item's
+// SellerReturnItemID must be unique and makes the call safe to retry.
+func (s *Client) CreateFulfillmentReturn(ctx context.Context, sellerFulfillmentOrderID string, items []CreateReturnItem) (*CreateFulfillmentReturnResult, error) {
+	body := struct {
+		Items []CreateReturnItem `json:"items"`
+	}{Items: items}
+
+	var resp CreateFulfillmentReturnResult
+	if err := s.outboundRequest(ctx, "fbaOutbound.createFulfillmentReturn", http.MethodPut, fulfillmentOrderPath(sellerFulfillmentOrderID, "return"), nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListReturnReasonCodes returns the reason codes valid for sellerSKU.
+// sellerFulfillmentOrderID and language are optional.
+func (s *Client) ListReturnReasonCodes(ctx context.Context, sellerSKU, sellerFulfillmentOrderID, language string) ([]ReasonCodeDetails, error) {
+	qs := url.Values{}
+	qs.Set("sellerSku", sellerSKU)
+	qs.Set("marketplaceId", s.Marketplace.ID)
+	if sellerFulfillmentOrderID != "" {
+		qs.Set("sellerFulfillmentOrderId", sellerFulfillmentOrderID)
+	}
+	if language != "" {
+		qs.Set("language", language)
+	}
+
+	var resp struct {
+		ReasonCodeDetails []ReasonCodeDetails `json:"reasonCodeDetails"`
+	}
+	if err := s.outboundRequest(ctx, "fbaOutbound.listReturnReasonCodes", http.MethodGet, "/returnReasonCodes", qs, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ReasonCodeDetails, nil
+}
+
+// GetFeatures lists MCF features, e.g. BLANK_BOX or BLOCK_AMZL, and whether
+// the seller is eligible.
+func (s *Client) GetFeatures(ctx context.Context) ([]FulfillmentFeature, error) {
+	qs := url.Values{}
+	qs.Set("marketplaceId", s.Marketplace.ID)
+
+	var resp struct {
+		Features []FulfillmentFeature `json:"features"`
+	}
+	if err := s.outboundRequest(ctx, "fbaOutbound.getFeatures", http.MethodGet, "/features", qs, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Features, nil
+}
+
+// GetFeatureInventory lists the SKUs eligible for featureName, following
+// nextToken.
+func (s *Client) GetFeatureInventory(ctx context.Context, featureName string) ([]FeatureSKU, error) {
+	qs := url.Values{}
+	qs.Set("marketplaceId", s.Marketplace.ID)
+
+	var skus []FeatureSKU
+	for {
+		var resp struct {
+			NextToken   string       `json:"nextToken"`
+			FeatureSKUs []FeatureSKU `json:"featureSkus"`
+		}
+		if err := s.outboundRequest(ctx, "fbaOutbound.getFeatureInventory", http.MethodGet, "/features/inventory/"+url.PathEscape(featureName), qs, nil, &resp); err != nil {
+			return nil, err
+		}
+		skus = append(skus, resp.FeatureSKUs...)
+		if resp.NextToken == "" {
+			return skus, nil
+		}
+		qs.Set("nextToken", resp.NextToken)
+	}
+}
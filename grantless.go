@@ -0,0 +1,123 @@
+package spapi
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var (
+	ScopeNotifications            = "sellingpartnerapi::notifications"
+	ScopeClientCredentialRotation = "sellingpartnerapi::client_credential:rotation"
+)
+
+// GrantlessScopes maps operations that do not act on behalf of a seller to
+// the LWA scope they need. The auth middleware uses a client_credentials
+// token for these instead of the seller's refresh token.
+var GrantlessScopes = map[string]string{
+	"notifications.getDestinations":              ScopeNotifications,
+	"notifications.createDestination":            ScopeNotifications,
+	"notifications.getDestination":               ScopeNotifications,
+	"notifications.deleteDestination":            ScopeNotifications,
+	"notifications.getSubscriptionById":          ScopeNotifications,
+	"notifications.deleteSubscriptionById":       ScopeNotifications,
+	"applications.rotateApplicationClientSecret": ScopeClientCredentialRotation,
+}
+
+// GrantlessTokenProvider fetches client_credentials tokens and caches them
+// per scope until shortly before they expire.
+type GrantlessTokenProvider struct {
+	// Credentials returns the LWA client id and secret, read on every
+	// refresh so rotated secrets are picked up.
+	Credentials func() (clientID, clientSecret string)
+	HTTPClient  *http.Client
+
+	mu     sync.Mutex
+	tokens map[string]*oauth2.Token
+}
+
+// expiryDelta refreshes grantless tokens a little early so they do not
+// expire in flight.
+const expiryDelta = 1 * time.Minute
+
+// Token returns a cached token for scope or fetches a new one.
+func (p *GrantlessTokenProvider) Token(ctx context.Context, scope string) (*oauth2.Token, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if t, ok := p.tokens[scope]; ok && t.Expiry.After(time.Now().Add(expiryDelta)) {
+		return t, false, nil
+	}
+
+	clientID, clientSecret := p.Credentials()
+
+	body := url.Values{}
+	body.Set("grant_type", "client_credentials")
+	body.Set("scope", scope)
+	body.Set("client_id", clientID)
+	body.Set("client_secret", clientSecret)
+
+	t, err := requestLWAToken(ctx, p.HTTPClient, body)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if p.tokens == nil {
+		p.tokens = map[string]*oauth2.Token{}
+	}
+	p.tokens[scope] = t
+
+	return t, true, nil
+}
+
+// Invalidate drops the cached token for scope.
+func (p *GrantlessTokenProvider) Invalidate(scope string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.tokens, scope)
+}
+
+func (s *Client) grantlessProvider() *GrantlessTokenProvider {
+	s.grantlessOnce.Do(func() {
+		s.grantless = &GrantlessTokenProvider{
+			Credentials: s.credentials,
+			HTTPClient:  s.HTTPClient,
+		}
+	})
+	return s.grantless
+}
+
+// grantlessToken returns a token for scope, forcing a new one when force is
+// set.
+func (s *Client) grantlessToken(ctx context.Context, scope string, force bool) (*oauth2.Token, bool, error) {
+	p := s.grantlessProvider()
+	if force {
+		p.Invalidate(scope)
+	}
+
+	t, refreshed, err := p.Token(ctx, scope)
+	if err != nil {
+		return nil, false, err
+	}
+	if refreshed {
+		s.logger().LogAttrs(ctx, slog.LevelInfo, "fetched grantless LWA access token",
+			slog.String("scope", scope),
+			slog.Time("expiry", t.Expiry),
+		)
+	}
+	return t, refreshed, nil
+}
+
+// scopeFor returns the grantless scope for req, if any.
+func scopeFor(req *Request) string {
+	if req.Scope != "" {
+		return req.Scope
+	}
+	return GrantlessScopes[req.Operation]
+}
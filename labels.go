@@ -0,0 +1,70 @@
+package spapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type LabelFormat string
+
+var (
+	LabelFormatPDF    LabelFormat = "PDF"
+	LabelFormatPNG    LabelFormat = "PNG"
+	LabelFormatZPL    LabelFormat = "ZPL"
+	LabelFormatZPL203 LabelFormat = "ZPL203"
+	LabelFormatZPL300 LabelFormat = "ZPL300"
+)
+
+// ContentType returns the MIME type for f.
+func (f LabelFormat) ContentType() string {
+	switch {
+	case f == LabelFormatPDF:
+		return "application/pdf"
+	case f == LabelFormatPNG:
+		return "image/png"
+	case strings.HasPrefix(string(f), "ZPL"):
+		return "application/zpl"
+	}
+	return "application/octet-stream"
+}
+
+// DetectLabelFormat sniffs decoded label bytes. It returns "" for unknown
+// content.
+func DetectLabelFormat(b []byte) LabelFormat {
+	switch {
+	case bytes.HasPrefix(b, []byte("%PDF")):
+		return LabelFormatPDF
+	case bytes.HasPrefix(b, []byte("\x89PNG")):
+		return LabelFormatPNG
+	case bytes.HasPrefix(bytes.TrimSpace(b), []byte("^XA")), bytes.HasPrefix(bytes.TrimSpace(b), []byte("${")):
+		return LabelFormatZPL
+	}
+	return ""
+}
+
+// DecodeLabel decodes a base64 label document. Merchant Fulfillment labels
+// are gzipped before encoding; gzip content is detected and decompressed, so
+// the result is the raw PDF, PNG or ZPL bytes for either API.
+func DecodeLabel(contents string) ([]byte, LabelFormat, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(contents))
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding label: %w", err)
+	}
+
+	if len(b) > 2 && b[0] == 0x1f && b[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, "", fmt.Errorf("error decompressing label: %w", err)
+		}
+		defer zr.Close()
+
+		if b, err = io.ReadAll(zr); err != nil {
+			return nil, "", fmt.Errorf("error decompressing label: %w", err)
+		}
+	}
+	return b, DetectLabelFormat(b), nil
+}
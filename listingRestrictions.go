@@ -52,16 +52,15 @@ func (s *Client) GetListingRestrictions(ctx context.Context, asin string, condit
 		RawQuery: qs.Encode(),
 	}
 
-	req := request{
-		Method: http.MethodGet,
-		URL:    &u,
-		Token:  s.Token,
+	req := Request{
+		Operation:     "listingsRestrictions.getListingsRestrictions",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 500 * time.Millisecond,
 	}
 
-	res, err := s.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 500 * time.Millisecond,
-	})
+	res, err := s.do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
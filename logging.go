@@ -0,0 +1,234 @@
+package spapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are compared case-insensitively with "_" and "-" removed.
+var sensitiveKeys = map[string]bool{
+	"clientsecret":        true,
+	"refreshtoken":        true,
+	"accesstoken":         true,
+	"xamzaccesstoken":     true,
+	"restricteddatatoken": true,
+	"authorization":       true,
+	"email":               true,
+	"buyeremail":          true,
+	"buyername":           true,
+	"phone":               true,
+	"phonenumber":         true,
+	"name":                true,
+	"companyname":         true,
+	"addressline1":        true,
+	"addressline2":        true,
+	"addressline3":        true,
+	"city":                true,
+	"districtorcounty":    true,
+	"stateorregion":       true,
+	"stateorprovincecode": true,
+	"postalcode":          true,
+	"shipto":              true,
+	"shipfrom":            true,
+	"returnto":            true,
+	"buyertaxinfo":        true,
+	"spapioauthcode":      true,
+}
+
+// lwaTokenPattern matches LWA access and refresh tokens (Atza|, Atzr|) and
+// restricted data tokens (Atz.sprdt|) wherever they appear in free text.
+var lwaTokenPattern = regexp.MustCompile(`Atz[a-z.]*\|[A-Za-z0-9\-_.|/+=%]+`)
+
+// formSecretPattern matches sensitive values in form encoded bodies.
+var formSecretPattern = regexp.MustCompile(`(?i)((?:client_secret|refresh_token|access_token)=)[^&\s]+`)
+
+func isSensitiveKey(key string) bool {
+	k := strings.ToLower(key)
+	k = strings.ReplaceAll(k, "_", "")
+	k = strings.ReplaceAll(k, "-", "")
+	// address objects are redacted whole, e.g. ShipFromAddress or
+	// destinationAddress, whatever fields they hold
+	return sensitiveKeys[k] || strings.HasSuffix(k, "address")
+}
+
+func redactString(s string) string {
+	s = lwaTokenPattern.ReplaceAllString(s, redacted)
+	return formSecretPattern.ReplaceAllString(s, "${1}"+redacted)
+}
+
+// redactBody removes secrets and buyer PII from a response body before it is
+// printed or logged.
+func redactBody(b []byte) string {
+	var v any
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return redactString(string(b))
+	}
+
+	out, err := json.Marshal(redactJSON(v))
+	if err != nil {
+		return redactString(string(b))
+	}
+	return string(out)
+}
+
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if isSensitiveKey(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactJSON(val)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = redactJSON(v[i])
+		}
+		return v
+	case string:
+		return redactString(v)
+	default:
+		return v
+	}
+}
+
+// redactHandler scrubs sensitive attributes before passing records on.
+type redactHandler struct {
+	next slog.Handler
+}
+
+func (h redactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h redactHandler) Handle(ctx context.Context, r slog.Record) error {
+	nr := slog.NewRecord(r.Time, r.Level, redactString(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		nr.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, nr)
+}
+
+func (h redactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		out[i] = redactAttr(a)
+	}
+	return redactHandler{next: h.next.WithAttrs(out)}
+}
+
+func (h redactHandler) WithGroup(name string) slog.Handler {
+	return redactHandler{next: h.next.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	if isSensitiveKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, redactString(v.String()))
+	case slog.KindGroup:
+		attrs := v.Group()
+		out := make([]slog.Attr, len(attrs))
+		for i, ga := range attrs {
+			out[i] = redactAttr(ga)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(out...)}
+	case slog.KindAny:
+		switch x := v.Any().(type) {
+		case error:
+			return slog.String(a.Key, redactString(x.Error()))
+		case json.RawMessage:
+			return slog.String(a.Key, redactBody(x))
+		case []byte:
+			return slog.String(a.Key, redactBody(x))
+		default:
+			return slog.Any(a.Key, redactAny(x))
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
+
+// redactAny round-trips v through JSON so maps and structs get the same key
+// based redaction as bodies. Values that cannot be marshaled are redacted
+// whole.
+func redactAny(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return redacted
+	}
+
+	var out any
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&out); err != nil {
+		return redacted
+	}
+	return redactJSON(out)
+}
+
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+// logger returns the client's logger wrapped so secrets and PII are always
+// redacted. A nil Logger discards everything.
+func (s *Client) logger() *slog.Logger {
+	if s.Logger == nil {
+		return slog.New(discardHandler{})
+	}
+	return slog.New(redactHandler{next: s.Logger.Handler()})
+}
+
+// LogValue keeps credentials out of logs when a Client is logged directly.
+func (s *Client) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("client_id", s.ClientID),
+		slog.String("seller_id", s.SellerID),
+	}
+	if s.Marketplace != nil {
+		attrs = append(attrs, slog.String("marketplace_id", s.Marketplace.ID))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func (e Error) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.Int("status_code", e.StatusCode),
+		slog.String("body", redactBody(e.Body)),
+	}
+	if e.URL != nil {
+		attrs = append(attrs, slog.String("url", e.URL.String()))
+	}
+	if len(e.Errors) > 0 {
+		attrs = append(attrs, slog.String("code", e.Errors[0].Code))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue omits buyer information.
+func (o Order) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("amazon_order_id", o.AmazonOrderId),
+		slog.String("order_status", o.OrderStatus),
+		slog.String("marketplace_id", o.MarketplaceId),
+		slog.Time("purchase_date", o.PurchaseDate),
+	)
+}
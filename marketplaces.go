@@ -1,77 +1,367 @@
 package spapi
 
+import (
+	"strings"
+	"time"
+)
+
 type Marketplace struct {
 	ID       string
 	Endpoint string
+
+	CountryCode      string
+	Name             string
+	Currency         string
+	Language         string // default locale, e.g. en_US
+	TimeZone         string // IANA zone name
+	AWSRegion        string
+	SandboxEndpoint  string
+	SellerCentralURL string
 }
 
 var (
-	MarketplaceUS = Marketplace{
-		ID:       "ATVPDKIKX0DER",
-		Endpoint: EndpointNorthAmerica,
-	}
-	MarketplaceCA = Marketplace{
-		ID:       "A2EUQ1WTGCTBG2",
-		Endpoint: EndpointNorthAmerica,
-	}
-	MarketplaceMX = Marketplace{
-		ID:       "A1AM78C64UM0Y8",
-		Endpoint: EndpointNorthAmerica,
-	}
-	MarketplaceGB = Marketplace{
-		ID:       "A1F83G8C2ARO7P",
-		Endpoint: EndpointEurope,
-	}
-	MarketplaceDE = Marketplace{
-		ID:       "A1PA6795UKMFR9",
-		Endpoint: EndpointEurope,
-	}
-	MarketplaceFR = Marketplace{
-		ID:       "A13V1IB3VIYZZH",
-		Endpoint: EndpointEurope,
-	}
-	MarketplaceIT = Marketplace{
-		ID:       "APJ6JRA9NG5V4",
-		Endpoint: EndpointEurope,
+	SandboxEndpointNorthAmerica = "sandbox.sellingpartnerapi-na.amazon.com"
+	SandboxEndpointEurope       = "sandbox.sellingpartnerapi-eu.amazon.com"
+	SandboxEndpointFarEast      = "sandbox.sellingpartnerapi-fe.amazon.com"
+)
+
+var (
+	AWSRegionNorthAmerica = "us-east-1"
+	AWSRegionEurope       = "eu-west-1"
+	AWSRegionFarEast      = "us-west-2"
+)
+
+func northAmerica(m Marketplace) Marketplace {
+	m.Endpoint = EndpointNorthAmerica
+	m.AWSRegion = AWSRegionNorthAmerica
+	m.SandboxEndpoint = SandboxEndpointNorthAmerica
+	return m
+}
+
+func europe(m Marketplace) Marketplace {
+	m.Endpoint = EndpointEurope
+	m.AWSRegion = AWSRegionEurope
+	m.SandboxEndpoint = SandboxEndpointEurope
+	return m
+}
+
+func farEast(m Marketplace) Marketplace {
+	m.Endpoint = EndpointFarEast
+	m.AWSRegion = AWSRegionFarEast
+	m.SandboxEndpoint = SandboxEndpointFarEast
+	return m
+}
+
+var (
+	MarketplaceCA = northAmerica(Marketplace{
+		ID:               "A2EUQ1WTGCTBG2",
+		CountryCode:      "CA",
+		Name:             "Amazon.ca",
+		Currency:         "CAD",
+		Language:         "en_CA",
+		TimeZone:         "America/Toronto",
+		SellerCentralURL: "https://sellercentral.amazon.ca",
+	})
+	MarketplaceUS = northAmerica(Marketplace{
+		ID:               "ATVPDKIKX0DER",
+		CountryCode:      "US",
+		Name:             "Amazon.com",
+		Currency:         "USD",
+		Language:         "en_US",
+		TimeZone:         "America/Los_Angeles",
+		SellerCentralURL: "https://sellercentral.amazon.com",
+	})
+	MarketplaceMX = northAmerica(Marketplace{
+		ID:               "A1AM78C64UM0Y8",
+		CountryCode:      "MX",
+		Name:             "Amazon.com.mx",
+		Currency:         "MXN",
+		Language:         "es_MX",
+		TimeZone:         "America/Mexico_City",
+		SellerCentralURL: "https://sellercentral.amazon.com.mx",
+	})
+	MarketplaceBR = northAmerica(Marketplace{
+		ID:               "A2Q3Y263D00KWC",
+		CountryCode:      "BR",
+		Name:             "Amazon.com.br",
+		Currency:         "BRL",
+		Language:         "pt_BR",
+		TimeZone:         "America/Sao_Paulo",
+		SellerCentralURL: "https://sellercentral.amazon.com.br",
+	})
+	MarketplaceIE = europe(Marketplace{
+		ID:               "A28R8C7NBKEWEA",
+		CountryCode:      "IE",
+		Name:             "Amazon.ie",
+		Currency:         "EUR",
+		Language:         "en_IE",
+		TimeZone:         "Europe/Dublin",
+		SellerCentralURL: "https://sellercentral.amazon.ie",
+	})
+	MarketplaceES = europe(Marketplace{
+		ID:               "A1RKKUPIHCS9HS",
+		CountryCode:      "ES",
+		Name:             "Amazon.es",
+		Currency:         "EUR",
+		Language:         "es_ES",
+		TimeZone:         "Europe/Madrid",
+		SellerCentralURL: "https://sellercentral-europe.amazon.com",
+	})
+	MarketplaceGB = europe(Marketplace{
+		ID:               "A1F83G8C2ARO7P",
+		CountryCode:      "GB",
+		Name:             "Amazon.co.uk",
+		Currency:         "GBP",
+		Language:         "en_GB",
+		TimeZone:         "Europe/London",
+		SellerCentralURL: "https://sellercentral-europe.amazon.com",
+	})
+	MarketplaceFR = europe(Marketplace{
+		ID:               "A13V1IB3VIYZZH",
+		CountryCode:      "FR",
+		Name:             "Amazon.fr",
+		Currency:         "EUR",
+		Language:         "fr_FR",
+		TimeZone:         "Europe/Paris",
+		SellerCentralURL: "https://sellercentral-europe.amazon.com",
+	})
+	MarketplaceBE = europe(Marketplace{
+		ID:               "AMEN7PMS3EDWL",
+		CountryCode:      "BE",
+		Name:             "Amazon.com.be",
+		Currency:         "EUR",
+		Language:         "fr_BE",
+		TimeZone:         "Europe/Brussels",
+		SellerCentralURL: "https://sellercentral.amazon.com.be",
+	})
+	MarketplaceNL = europe(Marketplace{
+		ID:               "A1805IZSGTT6HS",
+		CountryCode:      "NL",
+		Name:             "Amazon.nl",
+		Currency:         "EUR",
+		Language:         "nl_NL",
+		TimeZone:         "Europe/Amsterdam",
+		SellerCentralURL: "https://sellercentral.amazon.nl",
+	})
+	MarketplaceDE = europe(Marketplace{
+		ID:               "A1PA6795UKMFR9",
+		CountryCode:      "DE",
+		Name:             "Amazon.de",
+		Currency:         "EUR",
+		Language:         "de_DE",
+		TimeZone:         "Europe/Berlin",
+		SellerCentralURL: "https://sellercentral-europe.amazon.com",
+	})
+	MarketplaceIT = europe(Marketplace{
+		ID:               "APJ6JRA9NG5V4",
+		CountryCode:      "IT",
+		Name:             "Amazon.it",
+		Currency:         "EUR",
+		Language:         "it_IT",
+		TimeZone:         "Europe/Rome",
+		SellerCentralURL: "https://sellercentral-europe.amazon.com",
+	})
+	MarketplaceSE = europe(Marketplace{
+		ID:               "A2NODRKZP88ZB9",
+		CountryCode:      "SE",
+		Name:             "Amazon.se",
+		Currency:         "SEK",
+		Language:         "sv_SE",
+		TimeZone:         "Europe/Stockholm",
+		SellerCentralURL: "https://sellercentral.amazon.se",
+	})
+	MarketplaceZA = europe(Marketplace{
+		ID:               "AE08WJ6YKNBMC",
+		CountryCode:      "ZA",
+		Name:             "Amazon.co.za",
+		Currency:         "ZAR",
+		Language:         "en_ZA",
+		TimeZone:         "Africa/Johannesburg",
+		SellerCentralURL: "https://sellercentral.amazon.co.za",
+	})
+	MarketplacePL = europe(Marketplace{
+		ID:               "A1C3SOZRARQ6R3",
+		CountryCode:      "PL",
+		Name:             "Amazon.pl",
+		Currency:         "PLN",
+		Language:         "pl_PL",
+		TimeZone:         "Europe/Warsaw",
+		SellerCentralURL: "https://sellercentral.amazon.pl",
+	})
+	MarketplaceEG = europe(Marketplace{
+		ID:               "ARBP9OOSHTCHU",
+		CountryCode:      "EG",
+		Name:             "Amazon.eg",
+		Currency:         "EGP",
+		Language:         "ar_EG",
+		TimeZone:         "Africa/Cairo",
+		SellerCentralURL: "https://sellercentral.amazon.eg",
+	})
+	MarketplaceTR = europe(Marketplace{
+		ID:               "A33AVAJ2PDY3EV",
+		CountryCode:      "TR",
+		Name:             "Amazon.com.tr",
+		Currency:         "TRY",
+		Language:         "tr_TR",
+		TimeZone:         "Europe/Istanbul",
+		SellerCentralURL: "https://sellercentral.amazon.com.tr",
+	})
+	MarketplaceSA = europe(Marketplace{
+		ID:               "A17E79C6D8DWNP",
+		CountryCode:      "SA",
+		Name:             "Amazon.sa",
+		Currency:         "SAR",
+		Language:         "ar_SA",
+		TimeZone:         "Asia/Riyadh",
+		SellerCentralURL: "https://sellercentral.amazon.sa",
+	})
+	MarketplaceAE = europe(Marketplace{
+		ID:               "A2VIGQ35RCS4UG",
+		CountryCode:      "AE",
+		Name:             "Amazon.ae",
+		Currency:         "AED",
+		Language:         "en_AE",
+		TimeZone:         "Asia/Dubai",
+		SellerCentralURL: "https://sellercentral.amazon.ae",
+	})
+	MarketplaceIN = europe(Marketplace{
+		ID:               "A21TJRUUN4KGV",
+		CountryCode:      "IN",
+		Name:             "Amazon.in",
+		Currency:         "INR",
+		Language:         "en_IN",
+		TimeZone:         "Asia/Kolkata",
+		SellerCentralURL: "https://sellercentral.amazon.in",
+	})
+	MarketplaceSG = farEast(Marketplace{
+		ID:               "A19VAU5U5O7RUS",
+		CountryCode:      "SG",
+		Name:             "Amazon.sg",
+		Currency:         "SGD",
+		Language:         "en_SG",
+		TimeZone:         "Asia/Singapore",
+		SellerCentralURL: "https://sellercentral.amazon.sg",
+	})
+	MarketplaceAU = farEast(Marketplace{
+		ID:               "A39IBJ37TRP1C6",
+		CountryCode:      "AU",
+		Name:             "Amazon.com.au",
+		Currency:         "AUD",
+		Language:         "en_AU",
+		TimeZone:         "Australia/Sydney",
+		SellerCentralURL: "https://sellercentral.amazon.com.au",
+	})
+	MarketplaceJP = farEast(Marketplace{
+		ID:               "A1VC38T7YXB528",
+		CountryCode:      "JP",
+		Name:             "Amazon.co.jp",
+		Currency:         "JPY",
+		Language:         "ja_JP",
+		TimeZone:         "Asia/Tokyo",
+		SellerCentralURL: "https://sellercentral.amazon.co.jp",
+	})
+)
+
+// Marketplaces lists every marketplace, grouped by region.
+var Marketplaces = []Marketplace{
+	MarketplaceCA,
+	MarketplaceUS,
+	MarketplaceMX,
+	MarketplaceBR,
+	MarketplaceIE,
+	MarketplaceES,
+	MarketplaceGB,
+	MarketplaceFR,
+	MarketplaceBE,
+	MarketplaceNL,
+	MarketplaceDE,
+	MarketplaceIT,
+	MarketplaceSE,
+	MarketplaceZA,
+	MarketplacePL,
+	MarketplaceEG,
+	MarketplaceTR,
+	MarketplaceSA,
+	MarketplaceAE,
+	MarketplaceIN,
+	MarketplaceSG,
+	MarketplaceAU,
+	MarketplaceJP,
+}
+
+// MarketplaceMap indexes Marketplaces by country code.
+var MarketplaceMap = func() map[string]Marketplace {
+	m := make(map[string]Marketplace, len(Marketplaces))
+	for _, mp := range Marketplaces {
+		m[mp.CountryCode] = mp
 	}
-	MarketplaceES = Marketplace{
-		ID:       "A1RKKUPIHCS9HS",
-		Endpoint: EndpointEurope,
+	return m
+}()
+
+var marketplacesByID = func() map[string]Marketplace {
+	m := make(map[string]Marketplace, len(Marketplaces))
+	for _, mp := range Marketplaces {
+		m[mp.ID] = mp
 	}
-	MarketplaceNL = Marketplace{
-		ID:       "A1805IZSGTT6HS",
-		Endpoint: EndpointEurope,
+	return m
+}()
+
+func MarketplaceByID(id string) (Marketplace, bool) {
+	m, ok := marketplacesByID[id]
+	return m, ok
+}
+
+func MarketplaceByCountry(countryCode string) (Marketplace, bool) {
+	countryCode = strings.ToUpper(countryCode)
+	if countryCode == "UK" {
+		countryCode = "GB"
 	}
-	MarketplaceSE = Marketplace{
-		ID:       "A2NODRKZP88ZB9",
-		Endpoint: EndpointEurope,
+	m, ok := MarketplaceMap[countryCode]
+	return m, ok
+}
+
+// LookupMarketplace finds a marketplace by country code or marketplace ID.
+func LookupMarketplace(s string) (Marketplace, bool) {
+	if m, ok := MarketplaceByCountry(s); ok {
+		return m, true
 	}
-	MarketplaceTR = Marketplace{
-		ID:       "A33AVAJ2PDY3EV",
-		Endpoint: EndpointEurope,
+	return MarketplaceByID(s)
+}
+
+// MarketplacesInRegion returns the marketplaces served by endpoint.
+func MarketplacesInRegion(endpoint string) []Marketplace {
+	var ms []Marketplace
+	for _, m := range Marketplaces {
+		if m.Endpoint == endpoint {
+			ms = append(ms, m)
+		}
 	}
-	MarketplaceAE = Marketplace{
-		ID:       "A2VIGQ35RCS4UG",
-		Endpoint: EndpointFarEast,
+	return ms
+}
+
+// GroupByRegion groups ms by endpoint, keeping their order within a region.
+func GroupByRegion(ms []Marketplace) map[string][]Marketplace {
+	groups := map[string][]Marketplace{}
+	for _, m := range ms {
+		groups[m.Endpoint] = append(groups[m.Endpoint], m)
 	}
-	MarketplaceJP = Marketplace{
-		ID:       "A1VC38T7YXB528",
-		Endpoint: EndpointFarEast,
+	return groups
+}
+
+// Location loads the marketplace's time zone, falling back to UTC when it
+// cannot be loaded. Binaries that run without system zoneinfo, e.g. in
+// scratch or distroless images, should import time/tzdata in their main
+// package.
+func (m Marketplace) Location() *time.Location {
+	loc, err := time.LoadLocation(m.TimeZone)
+	if err != nil {
+		return time.UTC
 	}
-)
+	return loc
+}
 
-var MarketplaceMap = map[string]Marketplace{
-	"US": MarketplaceUS,
-	"CA": MarketplaceCA,
-	"MX": MarketplaceMX,
-	"GB": MarketplaceGB,
-	"DE": MarketplaceDE,
-	"FR": MarketplaceFR,
-	"IT": MarketplaceIT,
-	"ES": MarketplaceES,
-	"NL": MarketplaceNL,
-	"SE": MarketplaceSE,
-	"TR": MarketplaceTR,
-	"AE": MarketplaceAE,
-	"JP": MarketplaceJP,
+// Sandbox returns a copy of m that sends requests to the sandbox endpoint.
+func (m Marketplace) Sandbox() Marketplace {
+	m.Endpoint = m.SandboxEndpoint
+	return m
 }
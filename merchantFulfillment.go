@@ -0,0 +1,377 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+	"unicode/utf8"
+)
+
+type MFNAddress struct {
+	Name                string `json:"Name"`
+	AddressLine1        string `json:"AddressLine1"`
+	AddressLine2        string `json:"AddressLine2,omitempty"`
+	AddressLine3        string `json:"AddressLine3,omitempty"`
+	DistrictOrCounty    string `json:"DistrictOrCounty,omitempty"`
+	Email               string `json:"Email"`
+	City                string `json:"City"`
+	StateOrProvinceCode string `json:"StateOrProvinceCode,omitempty"`
+	PostalCode          string `json:"PostalCode"`
+	CountryCode         string `json:"CountryCode"`
+	Phone               string `json:"Phone"`
+}
+
+type DimensionUnit string
+
+var (
+	DimensionUnitInches      DimensionUnit = "inches"
+	DimensionUnitCentimeters DimensionUnit = "centimeters"
+)
+
+// PackageDimensions sets either Length, Width, Height and Unit, or a
+// PredefinedPackageDimensions name such as FedEx_Box_10kg.
+type PackageDimensions struct {
+	Length                      float64       `json:"Length,omitempty"`
+	Width                       float64       `json:"Width,omitempty"`
+	Height                      float64       `json:"Height,omitempty"`
+	Unit                        DimensionUnit `json:"Unit,omitempty"`
+	PredefinedPackageDimensions string        `json:"PredefinedPackageDimensions,omitempty"`
+}
+
+type WeightUnit string
+
+var (
+	WeightUnitOunces WeightUnit = "oz"
+	WeightUnitGrams  WeightUnit = "g"
+)
+
+type Weight struct {
+	Value float64    `json:"Value"`
+	Unit  WeightUnit `json:"Unit"`
+}
+
+type DeliveryExperience string
+
+var (
+	DeliveryExperienceAdultSignature DeliveryExperience = "DeliveryConfirmationWithAdultSignature"
+	DeliveryExperienceSignature      DeliveryExperience = "DeliveryConfirmationWithSignature"
+	DeliveryExperienceNoSignature    DeliveryExperience = "DeliveryConfirmationWithoutSignature"
+	DeliveryExperienceNoTracking     DeliveryExperience = "NoTracking"
+)
+
+type ShippingServiceOptions struct {
+	DeliveryExperience      DeliveryExperience `json:"DeliveryExperience"`
+	DeclaredValue           *Money             `json:"DeclaredValue,omitempty"`
+	CarrierWillPickUp       bool               `json:"CarrierWillPickUp"`
+	CarrierWillPickUpOption string             `json:"CarrierWillPickUpOption,omitempty"` // CarrierWillPickUp, ShipperWillDropOff, NoPreference
+	LabelFormat             LabelFormat        `json:"LabelFormat,omitempty"`
+}
+
+type AdditionalSellerInput struct {
+	DataType         string      `json:"DataType"`
+	ValueAsString    string      `json:"ValueAsString,omitempty"`
+	ValueAsBoolean   *bool       `json:"ValueAsBoolean,omitempty"`
+	ValueAsInteger   *int        `json:"ValueAsInteger,omitempty"`
+	ValueAsTimestamp *time.Time  `json:"ValueAsTimestamp,omitempty"`
+	ValueAsAddress   *MFNAddress `json:"ValueAsAddress,omitempty"`
+	ValueAsWeight    *Weight     `json:"ValueAsWeight,omitempty"`
+	ValueAsCurrency  *Money      `json:"ValueAsCurrency,omitempty"`
+}
+
+type AdditionalSellerInputs struct {
+	AdditionalInputFieldName string                `json:"AdditionalInputFieldName"`
+	AdditionalSellerInput    AdditionalSellerInput `json:"AdditionalSellerInput"`
+}
+
+type MFNItem struct {
+	OrderItemID               string                   `json:"OrderItemId"`
+	Quantity                  int                      `json:"Quantity"`
+	ItemWeight                *Weight                  `json:"ItemWeight,omitempty"`
+	ItemDescription           string                   `json:"ItemDescription,omitempty"`
+	TransparencyCodeList      []string                 `json:"TransparencyCodeList,omitempty"`
+	ItemLevelSellerInputsList []AdditionalSellerInputs `json:"ItemLevelSellerInputsList,omitempty"`
+}
+
+type ShipmentRequestDetails struct {
+	AmazonOrderID          string                 `json:"AmazonOrderId"`
+	SellerOrderID          string                 `json:"SellerOrderId,omitempty"`
+	ItemList               []MFNItem              `json:"ItemList"`
+	ShipFromAddress        MFNAddress             `json:"ShipFromAddress"`
+	PackageDimensions      PackageDimensions      `json:"PackageDimensions"`
+	Weight                 Weight                 `json:"Weight"`
+	MustArriveByDate       *time.Time             `json:"MustArriveByDate,omitempty"`
+	ShipDate               *time.Time             `json:"ShipDate,omitempty"`
+	ShippingServiceOptions ShippingServiceOptions `json:"ShippingServiceOptions"`
+}
+
+// NewShipmentRequestDetails pre-fills a request from order and its items,
+// shipping every unshipped unit. Package dimensions and weight still have to
+// be set.
+func NewShipmentRequestDetails(order Order, items []OrderItem, shipFrom MFNAddress) ShipmentRequestDetails {
+	d := ShipmentRequestDetails{
+		AmazonOrderID:   order.AmazonOrderId,
+		SellerOrderID:   order.SellerOrderId,
+		ShipFromAddress: shipFrom,
+		ShippingServiceOptions: ShippingServiceOptions{
+			DeliveryExperience: DeliveryExperienceNoSignature,
+		},
+	}
+	if !order.EarliestShipDate.IsZero() {
+		shipDate := order.EarliestShipDate
+		if now := time.Now().UTC(); shipDate.Before(now) {
+			shipDate = now
+		}
+		d.ShipDate = &shipDate
+	}
+
+	for _, item := range items {
+		qty := item.Unshipped()
+		if qty <= 0 {
+			continue
+		}
+		description := item.Title
+		if len(description) > 250 {
+			// cut on a rune boundary so multi-byte titles stay valid UTF-8
+			n := 250
+			for n > 0 && !utf8.RuneStart(description[n]) {
+				n--
+			}
+			description = description[:n]
+		}
+		d.ItemList = append(d.ItemList, MFNItem{
+			OrderItemID:     item.OrderItemId,
+			Quantity:        qty,
+			ItemDescription: description,
+		})
+	}
+	return d
+}
+
+type LabelFormatOption struct {
+	IncludePackingSlipWithLabel bool        `json:"IncludePackingSlipWithLabel"`
+	LabelFormat                 LabelFormat `json:"LabelFormat,omitempty"`
+}
+
+type ShippingService struct {
+	ShippingServiceName            string                 `json:"ShippingServiceName"`
+	CarrierName                    string                 `json:"CarrierName"`
+	ShippingServiceID              string                 `json:"ShippingServiceId"`
+	ShippingServiceOfferID         string                 `json:"ShippingServiceOfferId"`
+	ShipDate                       time.Time              `json:"ShipDate"`
+	EarliestEstimatedDeliveryDate  *time.Time             `json:"EarliestEstimatedDeliveryDate"`
+	LatestEstimatedDeliveryDate    *time.Time             `json:"LatestEstimatedDeliveryDate"`
+	Rate                           Money                  `json:"Rate"`
+	ShippingServiceOptions         ShippingServiceOptions `json:"ShippingServiceOptions"`
+	AvailableLabelFormats          []LabelFormat          `json:"AvailableLabelFormats"`
+	AvailableFormatOptionsForLabel []LabelFormatOption    `json:"AvailableFormatOptionsForLabel"`
+	RequiresAdditionalSellerInputs bool                   `json:"RequiresAdditionalSellerInputs"`
+}
+
+type RejectedShippingService struct {
+	CarrierName            string `json:"CarrierName"`
+	ShippingServiceName    string `json:"ShippingServiceName"`
+	ShippingServiceID      string `json:"ShippingServiceId"`
+	RejectionReasonCode    string `json:"RejectionReasonCode"`
+	RejectionReasonMessage string `json:"RejectionReasonMessage"`
+}
+
+type EligibleShipmentServices struct {
+	ShippingServiceList               []ShippingService         `json:"ShippingServiceList"`
+	RejectedShippingServiceList       []RejectedShippingService `json:"RejectedShippingServiceList"`
+	TemporarilyUnavailableCarrierList []struct {
+		CarrierName string `json:"CarrierName"`
+	} `json:"TemporarilyUnavailableCarrierList"`
+	TermsAndConditionsNotAcceptedCarrierList []struct {
+		CarrierName string `json:"CarrierName"`
+	} `json:"TermsAndConditionsNotAcceptedCarrierList"`
+}
+
+// Cheapest returns the lowest-rated service, or nil if there are none.
+func (e *EligibleShipmentServices) Cheapest() *ShippingService {
+	var best *ShippingService
+	for i := range e.ShippingServiceList {
+		svc := &e.ShippingServiceList[i]
+		if best == nil || svc.Rate.Amount.Cmp(best.Rate.Amount) < 0 {
+			best = svc
+		}
+	}
+	return best
+}
+
+type FileContents struct {
+	Contents string `json:"Contents"`
+	FileType string `json:"FileType"` // application/pdf, application/zpl, image/png
+	Checksum string `json:"Checksum"`
+}
+
+type MFNLabel struct {
+	CustomTextForLabel string `json:"CustomTextForLabel"`
+	Dimensions         struct {
+		Length float64 `json:"Length"`
+		Width  float64 `json:"Width"`
+		Unit   string  `json:"Unit"`
+	} `json:"Dimensions"`
+	FileContents       FileContents `json:"FileContents"`
+	LabelFormat        LabelFormat  `json:"LabelFormat"`
+	StandardIDForLabel string       `json:"StandardIdForLabel"`
+}
+
+// Decode returns the label document as raw PDF, PNG or ZPL bytes.
+func (l *MFNLabel) Decode() ([]byte, LabelFormat, error) {
+	b, format, err := DecodeLabel(l.FileContents.Contents)
+	if err != nil {
+		return nil, "", err
+	}
+	if format == "" {
+		format = l.LabelFormat
+	}
+	return b, format, nil
+}
+
+type MFNShipment struct {
+	ShipmentID        string            `json:"ShipmentId"`
+	AmazonOrderID     string            `json:"AmazonOrderId"`
+	SellerOrderID     string            `json:"SellerOrderId"`
+	ItemList          []MFNItem         `json:"ItemList"`
+	ShipFromAddress   MFNAddress        `json:"ShipFromAddress"`
+	ShipToAddress     MFNAddress        `json:"ShipToAddress"`
+	PackageDimensions PackageDimensions `json:"PackageDimensions"`
+	Weight            Weight            `json:"Weight"`
+	Insurance         Money             `json:"Insurance"`
+	ShippingService   ShippingService   `json:"ShippingService"`
+	Label             MFNLabel          `json:"Label"`
+	Status            string            `json:"Status"` // Purchased, RefundPending, RefundRejected, RefundApplied
+	TrackingID        string            `json:"TrackingId"`
+	CreatedDate       time.Time         `json:"CreatedDate"`
+	LastUpdatedDate   *time.Time        `json:"LastUpdatedDate"`
+}
+
+type CreateShipmentRequest struct {
+	ShipmentRequestDetails        ShipmentRequestDetails   `json:"ShipmentRequestDetails"`
+	ShippingServiceID             string                   `json:"ShippingServiceId"`
+	ShippingServiceOfferID        string                   `json:"ShippingServiceOfferId,omitempty"`
+	HazmatType                    string                   `json:"HazmatType,omitempty"` // None, LQHazmat
+	LabelFormatOption             *LabelFormatOption       `json:"LabelFormatOption,omitempty"`
+	ShipmentLevelSellerInputsList []AdditionalSellerInputs `json:"ShipmentLevelSellerInputsList,omitempty"`
+}
+
+type SellerInputDefinition struct {
+	IsRequired          bool                  `json:"IsRequired"`
+	DataType            string                `json:"DataType"`
+	InputDisplayText    string                `json:"InputDisplayText"`
+	InputTarget         string                `json:"InputTarget"` // SHIPMENT_LEVEL, ITEM_LEVEL
+	StoredValue         AdditionalSellerInput `json:"StoredValue"`
+	RestrictedSetValues []string              `json:"RestrictedSetValues"`
+	Constraints         []struct {
+		ValidationRegEx  string `json:"ValidationRegEx"`
+		ValidationString string `json:"ValidationString"`
+	} `json:"Constraints"`
+}
+
+type AdditionalInputs struct {
+	AdditionalInputFieldName string                `json:"AdditionalInputFieldName"`
+	SellerInputDefinition    SellerInputDefinition `json:"SellerInputDefinition"`
+}
+
+type AdditionalSellerInputsResult struct {
+	ShipmentLevelFields []AdditionalInputs `json:"ShipmentLevelFields"`
+	ItemLevelFieldsList []struct {
+		ASIN             string             `json:"Asin"`
+		AdditionalInputs []AdditionalInputs `json:"AdditionalInputs"`
+	} `json:"ItemLevelFieldsList"`
+}
+
+func (s *Client) mfnRequest(ctx context.Context, operation, method, path string, body, out any) error {
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+	}
+	setEscapedPath(&u, "/mfn/v0"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		req.Body = b
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resp := struct {
+		Payload any `json:"payload"`
+	}{Payload: out}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// GetEligibleShipmentServices rate-shops the shipment across carriers.
+func (s *Client) GetEligibleShipmentServices(ctx context.Context, details ShipmentRequestDetails) (*EligibleShipmentServices, error) {
+	body := struct {
+		ShipmentRequestDetails ShipmentRequestDetails `json:"ShipmentRequestDetails"`
+	}{ShipmentRequestDetails: details}
+
+	var resp EligibleShipmentServices
+	if err := s.mfnRequest(ctx, "merchantFulfillment.getEligibleShipmentServices", http.MethodPost, "/eligibleShippingServices", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateShipment purchases a label for the chosen service.
+func (s *Client) CreateShipment(ctx context.Context, req CreateShipmentRequest) (*MFNShipment, error) {
+	var resp MFNShipment
+	if err := s.mfnRequest(ctx, "merchantFulfillment.createShipment", http.MethodPost, "/shipments", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) GetShipment(ctx context.Context, shipmentID string) (*MFNShipment, error) {
+	var resp MFNShipment
+	if err := s.mfnRequest(ctx, "merchantFulfillment.getShipment", http.MethodGet, "/shipments/"+url.PathEscape(shipmentID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelShipment voids the label and requests a refund.
+func (s *Client) CancelShipment(ctx context.Context, shipmentID string) (*MFNShipment, error) {
+	var resp MFNShipment
+	if err := s.mfnRequest(ctx, "merchantFulfillment.cancelShipment", http.MethodDelete, "/shipments/"+url.PathEscape(shipmentID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAdditionalSellerInputs lists the extra fields a service requires, for
+// services with RequiresAdditionalSellerInputs set.
+func (s *Client) GetAdditionalSellerInputs(ctx context.Context, shippingServiceID, orderID string, shipFrom MFNAddress) (*AdditionalSellerInputsResult, error) {
+	body := struct {
+		ShippingServiceID string     `json:"ShippingServiceId"`
+		ShipFromAddress   MFNAddress `json:"ShipFromAddress"`
+		OrderID           string     `json:"OrderId"`
+	}{ShippingServiceID: shippingServiceID, ShipFromAddress: shipFrom, OrderID: orderID}
+
+	var resp AdditionalSellerInputsResult
+	if err := s.mfnRequest(ctx, "merchantFulfillment.getAdditionalSellerInputs", http.MethodPost, "/additionalSellerInputs", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
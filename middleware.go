@@ -0,0 +1,234 @@
+package spapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Handler performs a single logical SP-API operation.
+type Handler interface {
+	Handle(ctx context.Context, req *Request) (*http.Response, error)
+}
+
+type HandlerFunc func(ctx context.Context, req *Request) (*http.Response, error)
+
+func (f HandlerFunc) Handle(ctx context.Context, req *Request) (*http.Response, error) {
+	return f(ctx, req)
+}
+
+// Middleware wraps a Handler to add behavior around an operation. Middlewares
+// see the operation name through req.Operation, the request before it is sent
+// and the response or error that comes back from the rest of the chain.
+type Middleware interface {
+	Wrap(next Handler) Handler
+}
+
+type MiddlewareFunc func(next Handler) Handler
+
+func (f MiddlewareFunc) Wrap(next Handler) Handler {
+	return f(next)
+}
+
+// chain builds a Handler where mws[0] is the outermost middleware and
+// final is called last.
+func chain(mws []Middleware, final HandlerFunc) Handler {
+	var h Handler = final
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i].Wrap(h)
+	}
+	return h
+}
+
+// DefaultMiddlewares returns the built-in chain: retry, rate limiting and
+// auth, in that order. Rebuild the slice to reorder or replace any of them.
+func (s *Client) DefaultMiddlewares() []Middleware {
+	return []Middleware{
+		s.RetryMiddleware(),
+		NewRateLimiter(DefaultRateLimits),
+		s.AuthMiddleware(),
+	}
+}
+
+// Use appends mws to the client's chain, inside the existing middlewares.
+// It is safe to call while requests are in flight; requests already running
+// keep the chain they started with. When the client is still on the default
+// chain, the same default middlewares are kept, so rate limiter state is not
+// lost.
+func (s *Client) Use(mws ...Middleware) {
+	s.mwMu.Lock()
+	defer s.mwMu.Unlock()
+
+	current := s.Middlewares
+	if current == nil {
+		current = s.defaultMiddlewares()
+	}
+	next := make([]Middleware, 0, len(current)+len(mws))
+	next = append(next, current...)
+	s.Middlewares = append(next, mws...)
+}
+
+// Wrap puts outer around the client's chain and inner inside it, next to the
+// HTTP call. Either may be nil. Like Use, it keeps the cached default chain
+// and is safe to call while requests are in flight.
+func (s *Client) Wrap(outer, inner Middleware) {
+	s.mwMu.Lock()
+	defer s.mwMu.Unlock()
+
+	current := s.Middlewares
+	if current == nil {
+		current = s.defaultMiddlewares()
+	}
+	next := make([]Middleware, 0, len(current)+2)
+	if outer != nil {
+		next = append(next, outer)
+	}
+	next = append(next, current...)
+	if inner != nil {
+		next = append(next, inner)
+	}
+	s.Middlewares = next
+}
+
+func (s *Client) middlewares() []Middleware {
+	s.mwMu.RLock()
+	mws := s.Middlewares
+	s.mwMu.RUnlock()
+	if mws != nil {
+		return mws
+	}
+	return s.defaultMiddlewares()
+}
+
+func (s *Client) defaultMiddlewares() []Middleware {
+	s.defaultsOnce.Do(func() {
+		s.defaults = s.DefaultMiddlewares()
+	})
+	return s.defaults
+}
+
+// AuthMiddleware sets the LWA access token on each request, using a grantless
+// token for operations that need one. A 401 response forces one token refresh
+// and a single resend.
+func (s *Client) AuthMiddleware() Middleware {
+	return MiddlewareFunc(func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+			token, refreshed, err := s.accessToken(ctx, req, false)
+			if err != nil {
+				return nil, err
+			}
+			if refreshed {
+				req.TokenRefreshes++
+			}
+			req.Token = token
+			req.Header.Set("x-amz-access-token", token.AccessToken)
+
+			res, err := next.Handle(ctx, req)
+			var spapiErr Error
+			if !errors.As(err, &spapiErr) || spapiErr.StatusCode != http.StatusUnauthorized {
+				return res, err
+			}
+
+			// only one retry for unauthorized because the new token is valid since it was just refreshed.
+			s.logger().LogAttrs(ctx, slog.LevelWarn, "spapi request unauthorized, refreshing token",
+				slog.String("operation", req.Operation),
+			)
+			token, _, err = s.accessToken(ctx, req, true)
+			if err != nil {
+				return nil, err
+			}
+			req.TokenRefreshes++
+			req.Token = token
+			req.Header.Set("x-amz-access-token", token.AccessToken)
+
+			return next.Handle(ctx, req)
+		})
+	})
+}
+
+func (s *Client) accessToken(ctx context.Context, req *Request, force bool) (*oauth2.Token, bool, error) {
+	if scope := scopeFor(req); scope != "" {
+		return s.grantlessToken(ctx, scope, force)
+	}
+	return s.refreshToken(ctx, force)
+}
+
+// RetryMiddleware resends throttled requests up to req.RetryLimit times,
+// waiting req.SleepDuration between attempts. QuotaExceeded responses wait
+// 30 seconds and do not count against the limit.
+func (s *Client) RetryMiddleware() Middleware {
+	return MiddlewareFunc(func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+			sleepDuration := req.SleepDuration
+			if sleepDuration == 0 {
+				sleepDuration = 1 * time.Second
+			}
+			retryLimit := req.RetryLimit
+			if retryLimit < 1 {
+				retryLimit = 1
+			}
+
+			var lastErr error
+			for i := 0; i < retryLimit; i++ {
+				req.Attempt++
+				res, err := next.Handle(ctx, req)
+				if err == nil {
+					return res, nil
+				}
+				lastErr = err
+
+				var spapiErr Error
+				if !errors.As(err, &spapiErr) || spapiErr.StatusCode != http.StatusTooManyRequests {
+					return nil, err
+				}
+
+				wait := sleepDuration
+				if len(spapiErr.Errors) > 0 && spapiErr.Errors[0].Code == "QuotaExceeded" {
+					i = 0
+					wait = 30 * time.Second
+					s.logger().LogAttrs(ctx, slog.LevelWarn, "spapi quota exceeded, waiting",
+						slog.String("operation", req.Operation),
+						slog.Duration("wait", wait),
+					)
+				} else {
+					s.logger().LogAttrs(ctx, slog.LevelInfo, "spapi request throttled, retrying",
+						slog.String("operation", req.Operation),
+						slog.Int("attempt", req.Attempt),
+						slog.Int("retry_limit", retryLimit),
+						slog.Duration("wait", wait),
+					)
+				}
+				req.ThrottleWait += wait
+				if err := sleep(ctx, wait); err != nil {
+					return nil, err
+				}
+			}
+
+			s.logger().LogAttrs(ctx, slog.LevelError, "spapi retry limit reached",
+				slog.String("operation", req.Operation),
+				slog.Int("retry_limit", retryLimit),
+			)
+			return nil, RetryError{
+				RetryCount:    retryLimit,
+				Err:           lastErr,
+				SleepDuration: sleepDuration,
+			}
+		})
+	})
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,223 @@
+package spapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrCurrencyMismatch = errors.New("spapi: currency mismatch")
+
+// Money is an amount in a currency. It is not comparable with ==, because
+// Amount is a Decimal; use Equal or Compare.
+//
+// Money reads and writes {CurrencyCode, Amount}. Types from APIs that use
+// another shape convert it in their own UnmarshalJSON.
+type Money struct {
+	CurrencyCode string  `json:"CurrencyCode"`
+	Amount       Decimal `json:"Amount"`
+}
+
+// NewMoney parses amount, e.g. NewMoney("EUR", "12.99").
+func NewMoney(currency, amount string) (Money, error) {
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{CurrencyCode: currency, Amount: d}, nil
+}
+
+// currencyMinorUnits lists ISO 4217 exponents that differ from 2.
+var currencyMinorUnits = map[string]int32{
+	"BHD": 3,
+	"CLP": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// MinorUnits returns the number of decimal digits used by currency.
+func MinorUnits(currency string) int32 {
+	if n, ok := currencyMinorUnits[strings.ToUpper(currency)]; ok {
+		return n
+	}
+	return 2
+}
+
+func (m Money) check(o Money) error {
+	if m.CurrencyCode != o.CurrencyCode {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.CurrencyCode, o.CurrencyCode)
+	}
+	return nil
+}
+
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+	return Money{CurrencyCode: m.CurrencyCode, Amount: m.Amount.Add(o.Amount)}, nil
+}
+
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+	return Money{CurrencyCode: m.CurrencyCode, Amount: m.Amount.Sub(o.Amount)}, nil
+}
+
+// Mul multiplies by a quantity or rate. The result is not rounded.
+func (m Money) Mul(factor Decimal) Money {
+	return Money{CurrencyCode: m.CurrencyCode, Amount: m.Amount.Mul(factor)}
+}
+
+// Compare returns -1, 0 or 1 like Decimal.Cmp. Different currencies cannot
+// be compared.
+func (m Money) Compare(o Money) (int, error) {
+	if err := m.check(o); err != nil {
+		return 0, err
+	}
+	return m.Amount.Cmp(o.Amount), nil
+}
+
+// Equal reports whether m and o have the same currency and amount. Use it
+// instead of ==.
+func (m Money) Equal(o Money) bool {
+	return m.CurrencyCode == o.CurrencyCode && m.Amount.Equal(o.Amount)
+}
+
+// Round rounds to the currency's minor units, e.g. cents or whole yen.
+func (m Money) Round() Money {
+	return Money{CurrencyCode: m.CurrencyCode, Amount: m.Amount.Round(MinorUnits(m.CurrencyCode))}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// Sum adds ms. An empty list sums to zero in currency.
+func Sum(currency string, ms ...Money) (Money, error) {
+	total := Money{CurrencyCode: currency}
+	for _, m := range ms {
+		var err error
+		if total, err = total.Add(m); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+func (m Money) String() string {
+	return m.Amount.StringFixed(MinorUnits(m.CurrencyCode)) + " " + m.CurrencyCode
+}
+
+type numberFormat struct {
+	decimal      string
+	group        string
+	symbolAfter  bool
+	symbolSpaced bool
+	// lakh groups digits above the thousands in twos, e.g. 12,34,567.
+	lakh bool
+}
+
+// groupBefore reports whether a group separator goes before a digit that has
+// n digits to its right.
+func (nf numberFormat) groupBefore(n int) bool {
+	if nf.lakh && n > 3 {
+		return (n-3)%2 == 0
+	}
+	return n > 0 && n%3 == 0
+}
+
+var localeFormats = map[string]numberFormat{
+	"en":    {decimal: ".", group: ","},
+	"ja":    {decimal: ".", group: ","},
+	"es_MX": {decimal: ".", group: ","},
+	"ar":    {decimal: ".", group: ",", symbolSpaced: true},
+	"en_IN": {decimal: ".", group: ",", lakh: true},
+	"en_ZA": {decimal: ",", group: " "},
+	"de":    {decimal: ",", group: ".", symbolAfter: true, symbolSpaced: true},
+	"es":    {decimal: ",", group: ".", symbolAfter: true, symbolSpaced: true},
+	"it":    {decimal: ",", group: ".", symbolAfter: true, symbolSpaced: true},
+	"nl":    {decimal: ",", group: ".", symbolSpaced: true},
+	"pt":    {decimal: ",", group: ".", symbolSpaced: true},
+	"tr":    {decimal: ",", group: "."},
+	"fr":    {decimal: ",", group: " ", symbolAfter: true, symbolSpaced: true},
+	"sv":    {decimal: ",", group: " ", symbolAfter: true, symbolSpaced: true},
+	"pl":    {decimal: ",", group: " ", symbolAfter: true, symbolSpaced: true},
+}
+
+var currencySymbols = map[string]string{
+	"AED": "AED",
+	"AUD": "$",
+	"BRL": "R$",
+	"CAD": "$",
+	"EGP": "EGP",
+	"EUR": "€",
+	"GBP": "£",
+	"INR": "₹",
+	"JPY": "￥",
+	"MXN": "$",
+	"PLN": "zł",
+	"SAR": "SAR",
+	"SEK": "kr",
+	"SGD": "S$",
+	"TRY": "₺",
+	"USD": "$",
+	"ZAR": "R",
+}
+
+// Format renders m rounded to its minor units using locale conventions, e.g.
+// "1.234,56 €" for de_DE or "$1,234.56" for en_US. Locales use the
+// Marketplace.Language form; unknown locales fall back to en_US grouping.
+func (m Money) Format(locale string) string {
+	nf, ok := localeFormats[locale]
+	if !ok {
+		lang, _, _ := strings.Cut(locale, "_")
+		if nf, ok = localeFormats[lang]; !ok {
+			nf = localeFormats["en"]
+		}
+	}
+
+	symbol, ok := currencySymbols[m.CurrencyCode]
+	if !ok {
+		symbol = m.CurrencyCode
+	}
+
+	places := MinorUnits(m.CurrencyCode)
+	s := m.Amount.Abs().StringFixed(places)
+	intPart, fracPart, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	for i, r := range intPart {
+		if i > 0 && nf.groupBefore(len(intPart)-i) {
+			b.WriteString(nf.group)
+		}
+		b.WriteRune(r)
+	}
+	num := b.String()
+	if fracPart != "" {
+		num += nf.decimal + fracPart
+	}
+
+	sep := ""
+	if nf.symbolSpaced || symbol == m.CurrencyCode {
+		sep = " "
+	}
+
+	var out string
+	if nf.symbolAfter {
+		out = num + sep + symbol
+	} else {
+		out = symbol + sep + num
+	}
+	if m.Amount.Sign() < 0 {
+		out = "-" + out
+	}
+	return out
+}
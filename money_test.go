@@ -0,0 +1,140 @@
+package spapi
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMoneyCurrencyMismatch(t *testing.T) {
+	usd := Money{CurrencyCode: "USD", Amount: MustDecimal("1.00")}
+	eur := Money{CurrencyCode: "EUR", Amount: MustDecimal("1.00")}
+
+	if _, err := usd.Add(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add: err = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := usd.Sub(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Sub: err = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := usd.Compare(eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Compare: err = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := Sum("USD", usd, eur); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Sum: err = %v, want ErrCurrencyMismatch", err)
+	}
+	if usd.Equal(eur) {
+		t.Error("Equal ignores the currency")
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	tests := []struct {
+		a, b      string
+		sum, diff string
+		cmp       int
+	}{
+		{"12.99", "0.01", "13.00", "12.98", 1},
+		{"-4.20", "4.2", "0.00", "-8.40", -1},
+		{"0.10", "0.1", "0.20", "0.00", 0},
+	}
+	for _, tt := range tests {
+		a, err := NewMoney("EUR", tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := Money{CurrencyCode: "EUR", Amount: MustDecimal(tt.b)}
+
+		sum, err := a.Add(b)
+		if err != nil || sum.Amount.String() != tt.sum {
+			t.Errorf("%s + %s = %s, %v, want %s", tt.a, tt.b, sum.Amount, err, tt.sum)
+		}
+		diff, err := a.Sub(b)
+		if err != nil || diff.Amount.String() != tt.diff {
+			t.Errorf("%s - %s = %s, %v, want %s", tt.a, tt.b, diff.Amount, err, tt.diff)
+		}
+		cmp, err := a.Compare(b)
+		if err != nil || cmp != tt.cmp {
+			t.Errorf("%s.Compare(%s) = %d, %v, want %d", tt.a, tt.b, cmp, err, tt.cmp)
+		}
+	}
+
+	total, err := Sum("USD")
+	if err != nil || !total.IsZero() || total.CurrencyCode != "USD" {
+		t.Errorf("Sum() = %v, %v, want 0.00 USD", total, err)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{Money{CurrencyCode: "EUR", Amount: MustDecimal("12.9")}, "12.90 EUR"},
+		{Money{CurrencyCode: "JPY", Amount: MustDecimal("1234.5")}, "1235 JPY"},
+		{Money{CurrencyCode: "KWD", Amount: MustDecimal("-1.0005")}, "-1.001 KWD"},
+		{Money{CurrencyCode: "USD"}, "0.00 USD"},
+	}
+	for _, tt := range tests {
+		if got := tt.m.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	tests := []struct {
+		currency, amount, locale string
+		want                     string
+	}{
+		{"USD", "1234.56", "en_US", "$1,234.56"},
+		{"USD", "-5", "en_US", "-$5.00"},
+		{"USD", "999.999", "en_US", "$1,000.00"},
+		{"EUR", "1234.56", "de_DE", "1.234,56\u00a0€"},
+		{"EUR", "1234567.8", "fr_FR", "1\u202f234\u202f567,80\u00a0€"},
+		{"JPY", "1234567", "ja_JP", "￥1,234,567"},
+		{"INR", "123", "en_IN", "₹123.00"},
+		{"INR", "1234", "en_IN", "₹1,234.00"},
+		{"INR", "123456", "en_IN", "₹1,23,456.00"},
+		{"INR", "1234567", "en_IN", "₹12,34,567.00"},
+		{"INR", "-123456789.5", "en_IN", "-₹12,34,56,789.50"},
+		{"CHF", "10", "en_US", "CHF\u00a010.00"},
+		{"USD", "1234.5", "xx_YY", "$1,234.50"},
+	}
+	for _, tt := range tests {
+		m, err := NewMoney(tt.currency, tt.amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := m.Format(tt.locale); got != tt.want {
+			t.Errorf("Format(%s %s, %s) = %q, want %q", tt.amount, tt.currency, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	m := Money{CurrencyCode: "USD", Amount: MustDecimal("12.50")}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"CurrencyCode":"USD","Amount":12.50}`; string(b) != want {
+		t.Errorf("Marshal = %s, want %s", b, want)
+	}
+
+	var back Money
+	if err := json.Unmarshal(b, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !back.Equal(m) {
+		t.Errorf("round trip = %v, want %v", back, m)
+	}
+
+	// API-specific shapes are not Money's wire format
+	var other Money
+	if err := json.Unmarshal([]byte(`{"CurrencyCode":"USD","CurrencyAmount":1}`), &other); err != nil {
+		t.Fatal(err)
+	}
+	if !other.IsZero() {
+		t.Errorf("CurrencyAmount decoded into Money: %v", other)
+	}
+}
@@ -0,0 +1,274 @@
+package spapi
+
+import "time"
+
+// Payload types for Notification.Decode. Each is the object inside the
+// notification's payload, e.g. payload.OrderChangeNotification for
+// ORDER_CHANGE, see payloadKeys.
+
+type OrderChangeTrigger struct {
+	TimeOfOrderChange time.Time `json:"TimeOfOrderChange"`
+	ChangeReason      string    `json:"ChangeReason"`
+}
+
+type OrderChangeItem struct {
+	OrderItemID string `json:"OrderItemId"`
+	SellerSKU   string `json:"SellerSKU"`
+	SupplyType  string `json:"SupplyType"`
+	Quantity    int    `json:"Quantity"`
+}
+
+type OrderChangeSummary struct {
+	MarketplaceID          string            `json:"MarketplaceId"`
+	OrderStatus            string            `json:"OrderStatus"`
+	PurchaseDate           time.Time         `json:"PurchaseDate"`
+	DestinationPostalCode  string            `json:"DestinationPostalCode"`
+	FulfillmentType        string            `json:"FulfillmentType"`
+	OrderType              string            `json:"OrderType"`
+	OrderPrograms          []string          `json:"OrderPrograms"`
+	ShippingPrograms       []string          `json:"ShippingPrograms"`
+	EasyShipShipmentStatus string            `json:"EasyShipShipmentStatus"`
+	EarliestShipDate       time.Time         `json:"EarliestShipDate"`
+	LatestShipDate         time.Time         `json:"LatestShipDate"`
+	EarliestDeliveryDate   time.Time         `json:"EarliestDeliveryDate"`
+	LatestDeliveryDate     time.Time         `json:"LatestDeliveryDate"`
+	CancelNotifyDate       time.Time         `json:"CancelNotifyDate"`
+	OrderItems             []OrderChangeItem `json:"OrderItems"`
+}
+
+// OrderChangeNotification is the ORDER_CHANGE payload.
+type OrderChangeNotification struct {
+	NotificationLevel  string             `json:"NotificationLevel"`
+	SellerID           string             `json:"SellerId"`
+	AmazonOrderID      string             `json:"AmazonOrderId"`
+	OrderChangeType    string             `json:"OrderChangeType"` // OrderStatusChange, BuyerRequestedChange
+	OrderChangeTrigger OrderChangeTrigger `json:"OrderChangeTrigger"`
+	Summary            OrderChangeSummary `json:"Summary"`
+}
+
+type OfferChangeTrigger struct {
+	MarketplaceID     string    `json:"MarketplaceId"`
+	ASIN              string    `json:"ASIN"`
+	ItemCondition     string    `json:"ItemCondition"`
+	TimeOfOfferChange time.Time `json:"TimeOfOfferChange"`
+	OfferChangeType   string    `json:"OfferChangeType"`
+}
+
+type OfferCount struct {
+	Condition          string `json:"Condition"`
+	FulfillmentChannel string `json:"FulfillmentChannel"`
+	OfferCount         int    `json:"OfferCount"`
+}
+
+type OfferPrice struct {
+	Condition          string `json:"Condition"`
+	FulfillmentChannel string `json:"FulfillmentChannel"`
+	LandedPrice        Money  `json:"LandedPrice"`
+	ListingPrice       Money  `json:"ListingPrice"`
+	Shipping           Money  `json:"Shipping"`
+}
+
+type SalesRanking struct {
+	ProductCategoryID string `json:"ProductCategoryId"`
+	Rank              int    `json:"Rank"`
+}
+
+type AnyOfferChangedSummary struct {
+	NumberOfOffers            []OfferCount   `json:"NumberOfOffers"`
+	BuyBoxEligibleOffers      []OfferCount   `json:"BuyBoxEligibleOffers"`
+	LowestPrices              []OfferPrice   `json:"LowestPrices"`
+	BuyBoxPrices              []OfferPrice   `json:"BuyBoxPrices"`
+	ListPrice                 *Money         `json:"ListPrice"`
+	CompetitivePriceThreshold *Money         `json:"CompetitivePriceThreshold"`
+	SalesRankings             []SalesRanking `json:"SalesRankings"`
+}
+
+type NotificationOffer struct {
+	SellerID             string `json:"SellerId"`
+	SubCondition         string `json:"SubCondition"`
+	SellerFeedbackRating struct {
+		FeedbackCount                int     `json:"FeedbackCount"`
+		SellerPositiveFeedbackRating float64 `json:"SellerPositiveFeedbackRating"`
+	} `json:"SellerFeedbackRating"`
+	ShippingTime struct {
+		MinimumHours     int    `json:"MinimumHours"`
+		MaximumHours     int    `json:"MaximumHours"`
+		AvailabilityType string `json:"AvailabilityType"`
+	} `json:"ShippingTime"`
+	ListingPrice Money `json:"ListingPrice"`
+	Shipping     Money `json:"Shipping"`
+	ShipsFrom    struct {
+		Country string `json:"Country"`
+		State   string `json:"State"`
+	} `json:"ShipsFrom"`
+	IsFulfilledByAmazon bool `json:"IsFulfilledByAmazon"`
+	IsBuyBoxWinner      bool `json:"IsBuyBoxWinner"`
+	PrimeInformation    struct {
+		IsPrime         bool `json:"IsPrime"`
+		IsNationalPrime bool `json:"IsNationalPrime"`
+	} `json:"PrimeInformation"`
+	IsExpeditedShippingAvailable bool `json:"IsExpeditedShippingAvailable"`
+	IsFeaturedMerchant           bool `json:"IsFeaturedMerchant"`
+	ShipsDomestically            bool `json:"ShipsDomestically"`
+}
+
+// AnyOfferChangedNotification is the ANY_OFFER_CHANGED payload.
+type AnyOfferChangedNotification struct {
+	SellerID           string                 `json:"SellerId"`
+	OfferChangeTrigger OfferChangeTrigger     `json:"OfferChangeTrigger"`
+	Summary            AnyOfferChangedSummary `json:"Summary"`
+	Offers             []NotificationOffer    `json:"Offers"`
+}
+
+// ReportProcessingFinishedNotification is the REPORT_PROCESSING_FINISHED
+// payload.
+type ReportProcessingFinishedNotification struct {
+	SellerID         string `json:"sellerId"`
+	AccountID        string `json:"accountId"`
+	ReportID         string `json:"reportId"`
+	ReportType       string `json:"reportType"`
+	ProcessingStatus string `json:"processingStatus"` // CANCELLED, DONE, FATAL
+	ReportDocumentID string `json:"reportDocumentId"`
+}
+
+// FeedProcessingFinishedNotification is the FEED_PROCESSING_FINISHED payload.
+type FeedProcessingFinishedNotification struct {
+	SellerID             string `json:"sellerId"`
+	AccountID            string `json:"accountId"`
+	FeedID               string `json:"feedId"`
+	FeedType             string `json:"feedType"`
+	ProcessingStatus     string `json:"processingStatus"` // CANCELLED, DONE, FATAL
+	ResultFeedDocumentID string `json:"resultFeedDocumentId"`
+}
+
+// ListingsItemStatusChangeNotification is the LISTINGS_ITEM_STATUS_CHANGE
+// payload.
+type ListingsItemStatusChangeNotification struct {
+	SellerID      string    `json:"SellerId"`
+	MarketplaceID string    `json:"MarketplaceId"`
+	ASIN          string    `json:"Asin"`
+	SKU           string    `json:"Sku"`
+	CreatedDate   time.Time `json:"CreatedDate"`
+	Status        []string  `json:"Status"` // BUYABLE, DISCOVERABLE
+}
+
+// ListingsItemIssuesChangeNotification is the LISTINGS_ITEM_ISSUES_CHANGE
+// payload.
+type ListingsItemIssuesChangeNotification struct {
+	SellerID           string   `json:"SellerId"`
+	MarketplaceID      string   `json:"MarketplaceId"`
+	ASIN               string   `json:"Asin"`
+	SKU                string   `json:"Sku"`
+	Severities         []string `json:"Severities"`
+	EnforcementActions []string `json:"EnforcementActions"`
+}
+
+type FulfillmentInventory struct {
+	InboundQuantityBreakdown struct {
+		Working   int `json:"Working"`
+		Shipped   int `json:"Shipped"`
+		Receiving int `json:"Receiving"`
+	} `json:"InboundQuantityBreakdown"`
+	Fulfillable               int `json:"Fulfillable"`
+	Unfulfillable             int `json:"Unfulfillable"`
+	Researching               int `json:"Researching"`
+	ReservedQuantityBreakdown struct {
+		WarehouseProcessing int `json:"WarehouseProcessing"`
+		Transshipping       int `json:"Transshipping"`
+		CustomerOrder       int `json:"CustomerOrder"`
+	} `json:"ReservedQuantityBreakdown"`
+	FutureSupply struct {
+		ReservedFutureSupplyQuantity int `json:"ReservedFutureSupplyQuantity"`
+		FutureSupplyBuyableQuantity  int `json:"FutureSupplyBuyableQuantity"`
+	} `json:"FutureSupply"`
+}
+
+// FBAInventoryAvailabilityChangesNotification is the
+// FBA_INVENTORY_AVAILABILITY_CHANGES payload.
+type FBAInventoryAvailabilityChangesNotification struct {
+	SellerID                          string `json:"SellerId"`
+	FNSKU                             string `json:"FNSKU"`
+	ASIN                              string `json:"ASIN"`
+	SKU                               string `json:"SKU"`
+	FulfillmentInventoryByMarketplace []struct {
+		MarketplaceID        string               `json:"MarketplaceId"`
+		FulfillmentInventory FulfillmentInventory `json:"FulfillmentInventory"`
+	} `json:"FulfillmentInventoryByMarketplace"`
+}
+
+type PricingHealthOffer struct {
+	Condition       string `json:"condition"`
+	FulfillmentType string `json:"fulfillmentType"`
+	ListingPrice    Money  `json:"listingPrice"`
+	ShippingPrice   Money  `json:"shippingPrice"`
+	LandedPrice     Money  `json:"landedPrice"`
+	Points          *struct {
+		PointsNumber int `json:"pointsNumber"`
+	} `json:"points"`
+}
+
+// PricingHealthNotification is the PRICING_HEALTH payload.
+type PricingHealthNotification struct {
+	IssueType          string             `json:"issueType"`
+	SellerID           string             `json:"sellerId"`
+	OfferChangeTrigger OfferChangeTrigger `json:"offerChangeTrigger"`
+	MerchantOffer      PricingHealthOffer `json:"merchantOffer"`
+	Summary            struct {
+		NumberOfOffers       []OfferCount         `json:"numberOfOffers"`
+		BuyBoxEligibleOffers []OfferCount         `json:"buyBoxEligibleOffers"`
+		BuyBoxPrices         []PricingHealthOffer `json:"buyBoxPrices"`
+		SalesRankings        []SalesRanking       `json:"salesRankings"`
+		ReferencePrice       struct {
+			AverageSellingPrice       *Money `json:"averageSellingPrice"`
+			CompetitivePriceThreshold *Money `json:"competitivePriceThreshold"`
+			RetailOfferPrice          *Money `json:"retailOfferPrice"`
+			MSRPPrice                 *Money `json:"msrpPrice"`
+		} `json:"referencePrice"`
+	} `json:"summary"`
+}
+
+// FBAOutboundShipmentStatusNotification is the FBA_OUTBOUND_SHIPMENT_STATUS
+// payload.
+type FBAOutboundShipmentStatusNotification struct {
+	SellerID         string `json:"SellerId"`
+	AmazonOrderID    string `json:"AmazonOrderId"`
+	AmazonShipmentID string `json:"AmazonShipmentId"`
+	ShipmentStatus   string `json:"ShipmentStatus"` // Created, Cancelled
+}
+
+// FulfillmentOrderStatusNotification is the FULFILLMENT_ORDER_STATUS payload.
+type FulfillmentOrderStatusNotification struct {
+	SellerID                 string    `json:"SellerId"`
+	EventType                string    `json:"EventType"` // Order, Shipment, Return
+	StatusUpdatedDateTime    time.Time `json:"StatusUpdatedDateTime"`
+	SellerFulfillmentOrderID string    `json:"SellerFulfillmentOrderId"`
+	FulfillmentOrderStatus   string    `json:"FulfillmentOrderStatus"`
+	FulfillmentShipment      *struct {
+		FulfillmentShipmentStatus   string    `json:"FulfillmentShipmentStatus"`
+		AmazonShipmentID            string    `json:"AmazonShipmentId"`
+		EstimatedArrivalDateTime    time.Time `json:"EstimatedArrivalDateTime"`
+		FulfillmentShipmentPackages []struct {
+			PackageNumber  int    `json:"PackageNumber"`
+			CarrierCode    string `json:"CarrierCode"`
+			TrackingNumber string `json:"TrackingNumber"`
+		} `json:"FulfillmentShipmentPackages"`
+	} `json:"FulfillmentShipment"`
+	FulfillmentReturnItem *struct {
+		ReceivedDateTime time.Time `json:"ReceivedDateTime"`
+		ReturnedQuantity int       `json:"ReturnedQuantity"`
+		SellerSKU        string    `json:"SellerSKU"`
+	} `json:"FulfillmentReturnItem"`
+}
+
+// payloadKeys names the wrapper object inside payload for notification types
+// that have one.
+var payloadKeys = map[NotificationType]string{
+	NotificationTypeOrderChange:                        "OrderChangeNotification",
+	NotificationTypeAnyOfferChanged:                    "AnyOfferChangedNotification",
+	NotificationTypeReportProcessingFinished:           "reportProcessingFinishedNotification",
+	NotificationTypeFeedProcessingFinished:             "feedProcessingFinishedNotification",
+	NotificationTypeFBAOutboundShipmentStatus:          "FBAOutboundShipmentStatusNotification",
+	NotificationTypeFulfillmentOrderStatus:             "FulfillmentOrderStatusNotification",
+	NotificationTypeApplicationOAuthClientNewSecret:    "applicationOAuthClientNewSecret",
+	NotificationTypeApplicationOAuthClientSecretExpiry: "applicationOAuthClientSecretExpiry",
+}
@@ -0,0 +1,244 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type NotificationType string
+
+var (
+	NotificationTypeAnyOfferChanged                    NotificationType = "ANY_OFFER_CHANGED"
+	NotificationTypeApplicationOAuthClientNewSecret    NotificationType = "APPLICATION_OAUTH_CLIENT_NEW_SECRET"
+	NotificationTypeApplicationOAuthClientSecretExpiry NotificationType = "APPLICATION_OAUTH_CLIENT_SECRET_EXPIRY"
+	NotificationTypeBrandedItemContentChange           NotificationType = "BRANDED_ITEM_CONTENT_CHANGE"
+	NotificationTypeFBAInventoryAvailabilityChanges    NotificationType = "FBA_INVENTORY_AVAILABILITY_CHANGES"
+	NotificationTypeFBAOutboundShipmentStatus          NotificationType = "FBA_OUTBOUND_SHIPMENT_STATUS"
+	NotificationTypeFeePromotion                       NotificationType = "FEE_PROMOTION"
+	NotificationTypeFeedProcessingFinished             NotificationType = "FEED_PROCESSING_FINISHED"
+	NotificationTypeFulfillmentOrderStatus             NotificationType = "FULFILLMENT_ORDER_STATUS"
+	NotificationTypeItemProductTypeChange              NotificationType = "ITEM_PRODUCT_TYPE_CHANGE"
+	NotificationTypeListingsItemIssuesChange           NotificationType = "LISTINGS_ITEM_ISSUES_CHANGE"
+	NotificationTypeListingsItemMfnQuantityChange      NotificationType = "LISTINGS_ITEM_MFN_QUANTITY_CHANGE"
+	NotificationTypeListingsItemStatusChange           NotificationType = "LISTINGS_ITEM_STATUS_CHANGE"
+	NotificationTypeOrderChange                        NotificationType = "ORDER_CHANGE"
+	NotificationTypePricingHealth                      NotificationType = "PRICING_HEALTH"
+	NotificationTypeProductTypeDefinitionsChange       NotificationType = "PRODUCT_TYPE_DEFINITIONS_CHANGE"
+	NotificationTypeReportProcessingFinished           NotificationType = "REPORT_PROCESSING_FINISHED"
+)
+
+type SQSResource struct {
+	ARN string `json:"arn"`
+}
+
+type EventBridgeResource struct {
+	Name      string `json:"name,omitempty"`
+	Region    string `json:"region"`
+	AccountID string `json:"accountId"`
+}
+
+type DestinationResource struct {
+	SQS         *SQSResource         `json:"sqs,omitempty"`
+	EventBridge *EventBridgeResource `json:"eventBridge,omitempty"`
+}
+
+type Destination struct {
+	Name          string              `json:"name"`
+	DestinationID string              `json:"destinationId"`
+	Resource      DestinationResource `json:"resource"`
+}
+
+// CreateDestinationRequest sets either SQS or EventBridge. EventBridge
+// destinations need only Region and AccountID; Amazon creates the partner
+// event source.
+type CreateDestinationRequest struct {
+	Name        string
+	SQS         *SQSResource
+	EventBridge *EventBridgeResource
+}
+
+type AggregationTimePeriod string
+
+var (
+	AggregationTimePeriodFiveMinutes AggregationTimePeriod = "FiveMinutes"
+	AggregationTimePeriodTenMinutes  AggregationTimePeriod = "TenMinutes"
+)
+
+type AggregationSettings struct {
+	AggregationTimePeriod AggregationTimePeriod `json:"aggregationTimePeriod"`
+}
+
+// EventFilter narrows which events are delivered. EventFilterType must match
+// the subscription's notification type: ANY_OFFER_CHANGED supports
+// MarketplaceIDs and AggregationSettings, ORDER_CHANGE supports
+// OrderChangeTypes.
+type EventFilter struct {
+	EventFilterType     NotificationType     `json:"eventFilterType"`
+	MarketplaceIDs      []string             `json:"marketplaceIds,omitempty"`
+	AggregationSettings *AggregationSettings `json:"aggregationSettings,omitempty"`
+	OrderChangeTypes    []string             `json:"orderChangeTypes,omitempty"` // OrderStatusChange, BuyerRequestedChange
+}
+
+type ProcessingDirective struct {
+	EventFilter *EventFilter `json:"eventFilter,omitempty"`
+}
+
+type Subscription struct {
+	SubscriptionID      string               `json:"subscriptionId"`
+	PayloadVersion      string               `json:"payloadVersion"`
+	DestinationID       string               `json:"destinationId"`
+	ProcessingDirective *ProcessingDirective `json:"processingDirective,omitempty"`
+}
+
+type CreateSubscriptionRequest struct {
+	PayloadVersion      string               `json:"payloadVersion"`
+	DestinationID       string               `json:"destinationId"`
+	ProcessingDirective *ProcessingDirective `json:"processingDirective,omitempty"`
+}
+
+// AnyOfferChangedFilter builds the processing directive for ANY_OFFER_CHANGED
+// limited to marketplaceIDs. A zero period disables aggregation.
+func AnyOfferChangedFilter(period AggregationTimePeriod, marketplaceIDs ...string) *ProcessingDirective {
+	f := &EventFilter{
+		EventFilterType: NotificationTypeAnyOfferChanged,
+		MarketplaceIDs:  marketplaceIDs,
+	}
+	if period != "" {
+		f.AggregationSettings = &AggregationSettings{AggregationTimePeriod: period}
+	}
+	return &ProcessingDirective{EventFilter: f}
+}
+
+// notificationsURL builds the URL for path, whose IDs are already escaped.
+func (s *Client) notificationsURL(path string) url.URL {
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+	}
+	setEscapedPath(&u, "/notifications/v1"+path)
+	return u
+}
+
+func (s *Client) notificationsRequest(ctx context.Context, operation, method, path string, body, out any) error {
+	u := s.notificationsURL(path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		req.Body = b
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+
+	resp := struct {
+		Payload any `json:"payload"`
+	}{Payload: out}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// GetDestinations lists the application's destinations. It uses a grantless
+// token.
+func (s *Client) GetDestinations(ctx context.Context) ([]Destination, error) {
+	var resp []Destination
+	if err := s.notificationsRequest(ctx, "notifications.getDestinations", http.MethodGet, "/destinations", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Client) GetDestination(ctx context.Context, destinationID string) (*Destination, error) {
+	var resp Destination
+	if err := s.notificationsRequest(ctx, "notifications.getDestination", http.MethodGet, "/destinations/"+url.PathEscape(destinationID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateDestination registers an SQS queue or EventBridge account. It uses a
+// grantless token.
+func (s *Client) CreateDestination(ctx context.Context, opts CreateDestinationRequest) (*Destination, error) {
+	if (opts.SQS == nil) == (opts.EventBridge == nil) {
+		return nil, fmt.Errorf("exactly one of SQS or EventBridge must be set")
+	}
+
+	body := struct {
+		ResourceSpecification DestinationResource `json:"resourceSpecification"`
+		Name                  string              `json:"name"`
+	}{
+		ResourceSpecification: DestinationResource{SQS: opts.SQS, EventBridge: opts.EventBridge},
+		Name:                  opts.Name,
+	}
+
+	var resp Destination
+	if err := s.notificationsRequest(ctx, "notifications.createDestination", http.MethodPost, "/destinations", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) DeleteDestination(ctx context.Context, destinationID string) error {
+	return s.notificationsRequest(ctx, "notifications.deleteDestination", http.MethodDelete, "/destinations/"+url.PathEscape(destinationID), nil, nil)
+}
+
+// CreateSubscription subscribes the seller to notificationType on
+// destinationID.
+func (s *Client) CreateSubscription(ctx context.Context, notificationType NotificationType, opts CreateSubscriptionRequest) (*Subscription, error) {
+	if opts.PayloadVersion == "" {
+		opts.PayloadVersion = "1.0"
+	}
+
+	var resp Subscription
+	if err := s.notificationsRequest(ctx, "notifications.createSubscription", http.MethodPost, "/subscriptions/"+string(notificationType), opts, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSubscription returns the seller's subscription for notificationType.
+func (s *Client) GetSubscription(ctx context.Context, notificationType NotificationType) (*Subscription, error) {
+	var resp Subscription
+	if err := s.notificationsRequest(ctx, "notifications.getSubscription", http.MethodGet, "/subscriptions/"+string(notificationType), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSubscriptionByID uses a grantless token.
+func (s *Client) GetSubscriptionByID(ctx context.Context, notificationType NotificationType, subscriptionID string) (*Subscription, error) {
+	var resp Subscription
+	path := "/subscriptions/" + string(notificationType) + "/" + url.PathEscape(subscriptionID)
+	if err := s.notificationsRequest(ctx, "notifications.getSubscriptionById", http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteSubscriptionByID uses a grantless token.
+func (s *Client) DeleteSubscriptionByID(ctx context.Context, notificationType NotificationType, subscriptionID string) error {
+	path := "/subscriptions/" + string(notificationType) + "/" + url.PathEscape(subscriptionID)
+	return s.notificationsRequest(ctx, "notifications.deleteSubscriptionById", http.MethodDelete, path, nil, nil)
+}
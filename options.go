@@ -0,0 +1,180 @@
+package spapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var DefaultHTTPTimeout = 30 * time.Second
+
+type Option func(*Client) error
+
+func WithCredentials(clientID, clientSecret string) Option {
+	return func(c *Client) error {
+		c.ClientID = clientID
+		c.ClientSecret = clientSecret
+		return nil
+	}
+}
+
+func WithRefreshToken(refreshToken string) Option {
+	return func(c *Client) error {
+		c.Token = &oauth2.Token{RefreshToken: refreshToken}
+		return nil
+	}
+}
+
+// WithToken sets a full token, e.g. one restored from a cache.
+func WithToken(token *oauth2.Token) Option {
+	return func(c *Client) error {
+		c.Token = token
+		return nil
+	}
+}
+
+func WithMarketplace(m Marketplace) Option {
+	return func(c *Client) error {
+		c.Marketplace = &m
+		return nil
+	}
+}
+
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) error {
+		c.HTTPClient = hc
+		return nil
+	}
+}
+
+func WithSellerID(sellerID string) Option {
+	return func(c *Client) error {
+		c.SellerID = sellerID
+		return nil
+	}
+}
+
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Client) error {
+		c.Logger = l
+		return nil
+	}
+}
+
+// WithShippingBusinessID sets Client.ShippingBusinessID.
+func WithShippingBusinessID(id string) Option {
+	return func(c *Client) error {
+		c.ShippingBusinessID = id
+		return nil
+	}
+}
+
+// WithClientSecretRotatedHook sets Client.OnClientSecretRotated.
+func WithClientSecretRotatedHook(fn func(ctx context.Context, r ClientSecretRotation) error) Option {
+	return func(c *Client) error {
+		c.OnClientSecretRotated = fn
+		return nil
+	}
+}
+
+// WithMiddlewares replaces the default middleware chain.
+func WithMiddlewares(mws ...Middleware) Option {
+	return func(c *Client) error {
+		c.Middlewares = mws
+		return nil
+	}
+}
+
+// WithEnv loads any of SPAPI_CLIENT_ID, SPAPI_CLIENT_SECRET,
+// SPAPI_REFRESH_TOKEN, SPAPI_SELLER_ID and SPAPI_MARKETPLACE that are set.
+func WithEnv() Option {
+	return func(c *Client) error {
+		return c.applyCredentials(credentials{
+			ClientID:     os.Getenv("SPAPI_CLIENT_ID"),
+			ClientSecret: os.Getenv("SPAPI_CLIENT_SECRET"),
+			RefreshToken: os.Getenv("SPAPI_REFRESH_TOKEN"),
+			SellerID:     os.Getenv("SPAPI_SELLER_ID"),
+			Marketplace:  os.Getenv("SPAPI_MARKETPLACE"),
+		})
+	}
+}
+
+// WithProfile loads a named profile from the shared credentials file. An
+// empty name uses SPAPI_PROFILE or "default".
+func WithProfile(name string) Option {
+	return func(c *Client) error {
+		path, err := SharedCredentialsFile()
+		if err != nil {
+			return err
+		}
+		return WithProfileFile(path, name)(c)
+	}
+}
+
+// WithProfileFile loads a named profile from the credentials file at path.
+func WithProfileFile(path, name string) Option {
+	return func(c *Client) error {
+		if name == "" {
+			name = os.Getenv("SPAPI_PROFILE")
+		}
+		if name == "" {
+			name = "default"
+		}
+
+		creds, err := loadProfile(path, name)
+		if err != nil {
+			return err
+		}
+		return c.applyCredentials(*creds)
+	}
+}
+
+func (c *Client) applyCredentials(creds credentials) error {
+	if creds.ClientID != "" {
+		c.ClientID = creds.ClientID
+	}
+	if creds.ClientSecret != "" {
+		c.ClientSecret = creds.ClientSecret
+	}
+	if creds.RefreshToken != "" {
+		c.Token = &oauth2.Token{RefreshToken: creds.RefreshToken}
+	}
+	if creds.SellerID != "" {
+		c.SellerID = creds.SellerID
+	}
+	if creds.Marketplace != "" {
+		m, ok := LookupMarketplace(creds.Marketplace)
+		if !ok {
+			return fmt.Errorf("unknown marketplace %q", creds.Marketplace)
+		}
+		c.Marketplace = &m
+	}
+	return nil
+}
+
+var (
+	ErrMissingCredentials  = errors.New("spapi: client id and client secret are required")
+	ErrMissingRefreshToken = errors.New("spapi: refresh token is required")
+	ErrMissingMarketplace  = errors.New("spapi: marketplace is required")
+)
+
+// Validate reports configuration that would make requests fail.
+func (c *Client) Validate() error {
+	var errs []error
+	if c.ClientID == "" || c.ClientSecret == "" {
+		errs = append(errs, ErrMissingCredentials)
+	}
+	if c.Token == nil || (c.Token.RefreshToken == "" && c.Token.AccessToken == "") {
+		errs = append(errs, ErrMissingRefreshToken)
+	}
+	if c.Marketplace == nil || c.Marketplace.ID == "" || c.Marketplace.Endpoint == "" {
+		errs = append(errs, ErrMissingMarketplace)
+	}
+	return errors.Join(errs...)
+}
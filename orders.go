@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -79,6 +80,10 @@ func (s *Client) paginateOrders(ctx context.Context, qs url.Values, limit int) (
 			}
 
 			resp.Orders = append(resp.Orders, nextPage.Orders...)
+			s.logger().LogAttrs(ctx, slog.LevelDebug, "fetched orders page",
+				slog.Int("page", i+1),
+				slog.Int("orders", len(resp.Orders)),
+			)
 			if nextPage.NextToken == "" {
 				break
 			}
@@ -96,16 +101,15 @@ func (s *Client) getOrders(ctx context.Context, qs url.Values) (*GetOrdersRespon
 		RawQuery: qs.Encode(),
 	}
 
-	req := request{
-		Method: http.MethodGet,
-		URL:    &u,
-		Token:  s.Token,
+	req := Request{
+		Operation:     "orders.getOrders",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Minute,
 	}
 
-	res, err := s.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 1 * time.Minute,
-	})
+	res, err := s.do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -165,3 +169,347 @@ func (s *Client) GetOrders(ctx context.Context, opts *GetOrdersRequest) ([]Order
 
 	return resp.Orders, nil
 }
+
+type OrderItem struct {
+	ASIN                 string `json:"ASIN"`
+	SellerSKU            string `json:"SellerSKU"`
+	OrderItemId          string `json:"OrderItemId"`
+	Title                string `json:"Title"`
+	QuantityOrdered      int    `json:"QuantityOrdered"`
+	QuantityShipped      int    `json:"QuantityShipped"`
+	ItemPrice            *Money `json:"ItemPrice"`
+	ItemTax              *Money `json:"ItemTax"`
+	ShippingPrice        *Money `json:"ShippingPrice"`
+	ShippingTax          *Money `json:"ShippingTax"`
+	PromotionDiscount    *Money `json:"PromotionDiscount"`
+	ConditionId          string `json:"ConditionId"`
+	ConditionSubtypeId   string `json:"ConditionSubtypeId"`
+	IsGift               string `json:"IsGift"`
+	IsTransparency       bool   `json:"IsTransparency"`
+	SerialNumberRequired bool   `json:"SerialNumberRequired"`
+	ProductInfo          struct {
+		NumberOfItems string `json:"NumberOfItems"`
+	} `json:"ProductInfo"`
+}
+
+// Unshipped returns QuantityOrdered minus QuantityShipped.
+func (i OrderItem) Unshipped() int {
+	return i.QuantityOrdered - i.QuantityShipped
+}
+
+// GetOrderItems returns every item of orderID, following NextToken.
+func (s *Client) GetOrderItems(ctx context.Context, orderID string) ([]OrderItem, error) {
+	qs := url.Values{}
+
+	var items []OrderItem
+	for {
+		u := url.URL{
+			Scheme:   "https",
+			Host:     s.Marketplace.Endpoint,
+			RawQuery: qs.Encode(),
+		}
+		setEscapedPath(&u, "/orders/v0/orders/"+url.PathEscape(orderID)+"/orderItems")
+
+		req := Request{
+			Operation:     "orders.getOrderItems",
+			Method:        http.MethodGet,
+			URL:           &u,
+			RetryLimit:    10,
+			SleepDuration: 2 * time.Second,
+		}
+
+		res, err := s.do(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Payload struct {
+				OrderItems []OrderItem `json:"OrderItems"`
+				NextToken  string      `json:"NextToken"`
+			} `json:"payload"`
+		}
+		err = json.NewDecoder(res.Body).Decode(&resp)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error decoding spapi response: %w", err)
+		}
+
+		items = append(items, resp.Payload.OrderItems...)
+		if resp.Payload.NextToken == "" {
+			return items, nil
+		}
+		qs.Set("NextToken", resp.Payload.NextToken)
+	}
+}
+
+// CarrierCode is an Orders API carrier code. Use CarrierCodeOther together
+// with PackageDetail.CarrierName for carriers that are not listed.
+type CarrierCode string
+
+var (
+	CarrierCodeAmazonShipping CarrierCode = "Amazon Shipping"
+	CarrierCodeUSPS           CarrierCode = "USPS"
+	CarrierCodeUPS            CarrierCode = "UPS"
+	CarrierCodeFedEx          CarrierCode = "FedEx"
+	CarrierCodeDHL            CarrierCode = "DHL"
+	CarrierCodeDHLExpress     CarrierCode = "DHL Express"
+	CarrierCodeOnTrac         CarrierCode = "OnTrac"
+	CarrierCodeRoyalMail      CarrierCode = "Royal Mail"
+	CarrierCodeDPD            CarrierCode = "DPD"
+	CarrierCodeHermes         CarrierCode = "Hermes"
+	CarrierCodeGLS            CarrierCode = "GLS"
+	CarrierCodeDeutschePost   CarrierCode = "Deutsche Post"
+	CarrierCodeCanadaPost     CarrierCode = "Canada Post"
+	CarrierCodeJapanPost      CarrierCode = "Japan Post"
+	CarrierCodeOther          CarrierCode = "Other"
+)
+
+type ConfirmShipmentOrderItem struct {
+	OrderItemID       string   `json:"orderItemId"`
+	Quantity          int      `json:"quantity"`
+	TransparencyCodes []string `json:"transparencyCodes,omitempty"`
+}
+
+type PackageDetail struct {
+	PackageReferenceID     string                     `json:"packageReferenceId"`
+	CarrierCode            CarrierCode                `json:"carrierCode"`
+	CarrierName            string                     `json:"carrierName,omitempty"`
+	ShippingMethod         string                     `json:"shippingMethod,omitempty"`
+	TrackingNumber         string                     `json:"trackingNumber"`
+	ShipDate               time.Time                  `json:"shipDate"`
+	ShipFromSupplySourceID string                     `json:"shipFromSupplySourceId,omitempty"`
+	OrderItems             []ConfirmShipmentOrderItem `json:"orderItems"`
+}
+
+// NewPackageDetail confirms every unshipped unit of items in one package.
+func NewPackageDetail(items []OrderItem, carrier CarrierCode, trackingNumber string) PackageDetail {
+	pkg := PackageDetail{
+		PackageReferenceID: "1",
+		CarrierCode:        carrier,
+		TrackingNumber:     trackingNumber,
+		ShipDate:           time.Now().UTC(),
+	}
+	for _, item := range items {
+		if qty := item.Unshipped(); qty > 0 {
+			pkg.OrderItems = append(pkg.OrderItems, ConfirmShipmentOrderItem{
+				OrderItemID: item.OrderItemId,
+				Quantity:    qty,
+			})
+		}
+	}
+	return pkg
+}
+
+func (s *Client) ordersWrite(ctx context.Context, operation, method, path string, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error marshaling request body: %w", err)
+	}
+
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+	}
+	setEscapedPath(&u, "/orders/v0/orders/"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		Body:          b,
+		RetryLimit:    10,
+		SleepDuration: 2 * time.Second,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+// orderMarketplace returns the order's marketplace, falling back to the
+// client's.
+func (s *Client) orderMarketplace(order Order) string {
+	if order.MarketplaceId != "" {
+		return order.MarketplaceId
+	}
+	return s.Marketplace.ID
+}
+
+// ConfirmShipment confirms an MFN package for order. codCollectionMethod is
+// only used for cash-on-delivery orders in Japan and may be empty.
+func (s *Client) ConfirmShipment(ctx context.Context, order Order, pkg PackageDetail, codCollectionMethod string) error {
+	if pkg.CarrierCode == CarrierCodeOther && pkg.CarrierName == "" {
+		return fmt.Errorf("spapi: carrier name is required for carrier code %q", CarrierCodeOther)
+	}
+	if len(pkg.OrderItems) == 0 {
+		return fmt.Errorf("spapi: package %q has no order items", pkg.PackageReferenceID)
+	}
+
+	body := struct {
+		PackageDetail       PackageDetail `json:"packageDetail"`
+		CodCollectionMethod string        `json:"codCollectionMethod,omitempty"`
+		MarketplaceID       string        `json:"marketplaceId"`
+	}{
+		PackageDetail:       pkg,
+		CodCollectionMethod: codCollectionMethod,
+		MarketplaceID:       s.orderMarketplace(order),
+	}
+	return s.ordersWrite(ctx, "orders.confirmShipment", http.MethodPost, url.PathEscape(order.AmazonOrderId)+"/shipmentConfirmation", body)
+}
+
+type ShipmentStatus string
+
+var (
+	ShipmentStatusReadyForPickup ShipmentStatus = "ReadyForPickup"
+	ShipmentStatusPickedUp       ShipmentStatus = "PickedUp"
+	ShipmentStatusRefusedPickup  ShipmentStatus = "RefusedPickup"
+)
+
+type ShipmentStatusOrderItem struct {
+	OrderItemID string `json:"orderItemId"`
+	Quantity    int    `json:"quantity"`
+}
+
+// UpdateShipmentStatus updates the pickup status of an in-store pickup
+// (ISPU) order. Without items the status applies to the whole order.
+func (s *Client) UpdateShipmentStatus(ctx context.Context, order Order, status ShipmentStatus, items ...ShipmentStatusOrderItem) error {
+	if !order.IsISPU {
+		return fmt.Errorf("spapi: order %s is not an in-store pickup order", order.AmazonOrderId)
+	}
+
+	body := struct {
+		MarketplaceID  string                    `json:"marketplaceId"`
+		ShipmentStatus ShipmentStatus            `json:"shipmentStatus"`
+		OrderItems     []ShipmentStatusOrderItem `json:"orderItems,omitempty"`
+	}{
+		MarketplaceID:  s.orderMarketplace(order),
+		ShipmentStatus: status,
+		OrderItems:     items,
+	}
+	return s.ordersWrite(ctx, "orders.updateShipmentStatus", http.MethodPost, url.PathEscape(order.AmazonOrderId)+"/shipment", body)
+}
+
+type VerificationStatus string
+
+var (
+	VerificationStatusPending   VerificationStatus = "Pending"
+	VerificationStatusApproved  VerificationStatus = "Approved"
+	VerificationStatusRejected  VerificationStatus = "Rejected"
+	VerificationStatusExpired   VerificationStatus = "Expired"
+	VerificationStatusCancelled VerificationStatus = "Cancelled"
+)
+
+type RegulatedInformationField struct {
+	FieldID    string `json:"FieldId"`
+	FieldLabel string `json:"FieldLabel"`
+	FieldType  string `json:"FieldType"` // Text, FileAttachment
+	FieldValue string `json:"FieldValue"`
+}
+
+type RejectionReason struct {
+	RejectionReasonID          string `json:"RejectionReasonId"`
+	RejectionReasonDescription string `json:"RejectionReasonDescription"`
+}
+
+type RegulatedOrderVerificationStatus struct {
+	Status                   VerificationStatus `json:"Status"`
+	RequiresMerchantAction   bool               `json:"RequiresMerchantAction"`
+	ValidRejectionReasons    []RejectionReason  `json:"ValidRejectionReasons"`
+	RejectionReason          *RejectionReason   `json:"RejectionReason"`
+	ReviewDate               *time.Time         `json:"ReviewDate"`
+	ExternalReviewerID       string             `json:"ExternalReviewerId"`
+	ValidVerificationDetails []struct {
+		VerificationDetailType    string               `json:"VerificationDetailType"`
+		ValidVerificationStatuses []VerificationStatus `json:"ValidVerificationStatuses"`
+	} `json:"ValidVerificationDetails"`
+}
+
+type OrderRegulatedInfo struct {
+	AmazonOrderID        string `json:"AmazonOrderId"`
+	RegulatedInformation struct {
+		Fields []RegulatedInformationField `json:"Fields"`
+	} `json:"RegulatedInformation"`
+	RequiresDosageLabel              bool                             `json:"RequiresDosageLabel"`
+	RegulatedOrderVerificationStatus RegulatedOrderVerificationStatus `json:"RegulatedOrderVerificationStatus"`
+}
+
+// GetOrderRegulatedInfo returns the verification details of an order with
+// HasRegulatedItems set.
+func (s *Client) GetOrderRegulatedInfo(ctx context.Context, orderID string) (*OrderRegulatedInfo, error) {
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+	}
+	setEscapedPath(&u, "/orders/v0/orders/"+url.PathEscape(orderID)+"/regulatedInfo")
+
+	req := Request{
+		Operation:     "orders.getOrderRegulatedInfo",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 2 * time.Second,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var resp struct {
+		Payload OrderRegulatedInfo `json:"payload"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return &resp.Payload, nil
+}
+
+type PrescriptionDetail struct {
+	PrescriptionID         string    `json:"prescriptionId"`
+	ExpirationDate         time.Time `json:"expirationDate"`
+	WrittenQuantity        int       `json:"writtenQuantity"`
+	TotalRefillsAuthorized int       `json:"totalRefillsAuthorized"`
+	RefillsRemaining       int       `json:"refillsRemaining"`
+	ClinicID               string    `json:"clinicId"`
+	UsageInstructions      string    `json:"usageInstructions"`
+}
+
+// UpdateVerificationStatusRequest sets RejectionReasonID when rejecting.
+// PrescriptionDetail is only needed by orders that require it.
+type UpdateVerificationStatusRequest struct {
+	Status             VerificationStatus
+	ExternalReviewerID string
+	RejectionReasonID  string
+	PrescriptionDetail *PrescriptionDetail
+}
+
+func (s *Client) UpdateVerificationStatus(ctx context.Context, orderID string, opts UpdateVerificationStatusRequest) error {
+	type verificationDetails struct {
+		PrescriptionDetail *PrescriptionDetail `json:"prescriptionDetail,omitempty"`
+	}
+	type status struct {
+		Status              VerificationStatus   `json:"status,omitempty"`
+		ExternalReviewerID  string               `json:"externalReviewerId"`
+		RejectionReasonID   string               `json:"rejectionReasonId,omitempty"`
+		VerificationDetails *verificationDetails `json:"verificationDetails,omitempty"`
+	}
+
+	body := struct {
+		RegulatedOrderVerificationStatus status `json:"regulatedOrderVerificationStatus"`
+	}{
+		RegulatedOrderVerificationStatus: status{
+			Status:             opts.Status,
+			ExternalReviewerID: opts.ExternalReviewerID,
+			RejectionReasonID:  opts.RejectionReasonID,
+		},
+	}
+	if opts.PrescriptionDetail != nil {
+		body.RegulatedOrderVerificationStatus.VerificationDetails = &verificationDetails{PrescriptionDetail: opts.PrescriptionDetail}
+	}
+	return s.ordersWrite(ctx, "orders.updateVerificationStatus", http.MethodPatch, url.PathEscape(orderID)+"/regulatedInfo", body)
+}
@@ -0,0 +1,218 @@
+// Package otelspapi provides OpenTelemetry tracing and metrics for spapi
+// clients.
+package otelspapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/nerdwarelabs/spapi"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const scope = "github.com/nerdwarelabs/spapi/otelspapi"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+type Option func(*config)
+
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// Instrumentation holds the tracer and instruments shared by the operation
+// and attempt middlewares.
+type Instrumentation struct {
+	tracer trace.Tracer
+
+	requests          metric.Int64Counter
+	throttles         metric.Int64Counter
+	tokenRefreshes    metric.Int64Counter
+	operationDuration metric.Float64Histogram
+	attemptDuration   metric.Float64Histogram
+	throttleWait      metric.Float64Histogram
+}
+
+// New creates an Instrumentation. The global providers are used unless
+// overridden by options.
+func New(opts ...Option) (*Instrumentation, error) {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(scope)
+	i := &Instrumentation{
+		tracer: cfg.tracerProvider.Tracer(scope),
+	}
+
+	var err error
+	if i.requests, err = meter.Int64Counter("spapi.requests",
+		metric.WithDescription("HTTP attempts sent to SP-API."),
+	); err != nil {
+		return nil, err
+	}
+	if i.throttles, err = meter.Int64Counter("spapi.throttles",
+		metric.WithDescription("Attempts rejected with 429 Too Many Requests."),
+	); err != nil {
+		return nil, err
+	}
+	if i.tokenRefreshes, err = meter.Int64Counter("spapi.token_refreshes",
+		metric.WithDescription("LWA access token refreshes."),
+	); err != nil {
+		return nil, err
+	}
+	if i.operationDuration, err = meter.Float64Histogram("spapi.operation.duration",
+		metric.WithDescription("Duration of logical operations including retries."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+	if i.attemptDuration, err = meter.Float64Histogram("spapi.attempt.duration",
+		metric.WithDescription("Duration of single HTTP attempts."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+	if i.throttleWait, err = meter.Float64Histogram("spapi.throttle.wait",
+		metric.WithDescription("Time spent waiting on throttled operations."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// Instrument wraps the client's current chain with an operation span on the
+// outside and an attempt span on the inside.
+func Instrument(c *spapi.Client, opts ...Option) error {
+	i, err := New(opts...)
+	if err != nil {
+		return err
+	}
+
+	c.Wrap(i.Operation(), i.Attempt())
+	return nil
+}
+
+// Operation returns a middleware that creates a span per logical operation,
+// e.g. orders.getOrders. It should be the outermost middleware.
+func (i *Instrumentation) Operation() spapi.Middleware {
+	return spapi.MiddlewareFunc(func(next spapi.Handler) spapi.Handler {
+		return spapi.HandlerFunc(func(ctx context.Context, req *spapi.Request) (*http.Response, error) {
+			ctx, span := i.tracer.Start(ctx, req.Operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("spapi.operation", req.Operation),
+					attribute.String("http.request.method", req.Method),
+				),
+			)
+			defer span.End()
+
+			start := time.Now()
+			res, err := next.Handle(ctx, req)
+
+			attrs := append(resultAttributes(res, err), attribute.String("spapi.operation", req.Operation))
+			span.SetAttributes(
+				attribute.Int("spapi.retry_count", max(req.Attempt-1, 0)),
+				attribute.Float64("spapi.throttle_wait", req.ThrottleWait.Seconds()),
+			)
+			span.SetAttributes(attrs...)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			set := metric.WithAttributes(attrs...)
+			i.operationDuration.Record(ctx, time.Since(start).Seconds(), set)
+			if req.ThrottleWait > 0 {
+				i.throttleWait.Record(ctx, req.ThrottleWait.Seconds(), metric.WithAttributes(attribute.String("spapi.operation", req.Operation)))
+			}
+			if req.TokenRefreshes > 0 {
+				i.tokenRefreshes.Add(ctx, int64(req.TokenRefreshes))
+			}
+
+			return res, err
+		})
+	})
+}
+
+// Attempt returns a middleware that creates a child span per HTTP attempt.
+// It should be the innermost middleware so it sees every retry. Spans are
+// named by operation; the raw path, which carries IDs, is only recorded in
+// url.path.
+func (i *Instrumentation) Attempt() spapi.Middleware {
+	return spapi.MiddlewareFunc(func(next spapi.Handler) spapi.Handler {
+		return spapi.HandlerFunc(func(ctx context.Context, req *spapi.Request) (*http.Response, error) {
+			ctx, span := i.tracer.Start(ctx, req.Operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("http.request.method", req.Method),
+					attribute.String("server.address", req.URL.Host),
+					attribute.String("url.path", req.URL.Path),
+					attribute.Int("spapi.attempt", req.Attempt),
+				),
+			)
+			defer span.End()
+
+			start := time.Now()
+			res, err := next.Handle(ctx, req)
+
+			attrs := append(resultAttributes(res, err), attribute.String("spapi.operation", req.Operation))
+			span.SetAttributes(attrs...)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			set := metric.WithAttributes(attrs...)
+			i.requests.Add(ctx, 1, set)
+			i.attemptDuration.Record(ctx, time.Since(start).Seconds(), set)
+
+			var spapiErr spapi.Error
+			if errors.As(err, &spapiErr) && spapiErr.StatusCode == http.StatusTooManyRequests {
+				i.throttles.Add(ctx, 1, metric.WithAttributes(attribute.String("spapi.operation", req.Operation)))
+			}
+
+			return res, err
+		})
+	})
+}
+
+func resultAttributes(res *http.Response, err error) []attribute.KeyValue {
+	if res != nil {
+		return []attribute.KeyValue{attribute.Int("http.response.status_code", res.StatusCode)}
+	}
+
+	var spapiErr spapi.Error
+	if !errors.As(err, &spapiErr) {
+		return nil
+	}
+
+	attrs := []attribute.KeyValue{attribute.Int("http.response.status_code", spapiErr.StatusCode)}
+	if len(spapiErr.Errors) > 0 {
+		attrs = append(attrs, attribute.String("spapi.error_code", spapiErr.Errors[0].Code))
+	}
+	return attrs
+}
@@ -0,0 +1,255 @@
+package spapi
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// RefreshTokenFunc returns the refresh token a seller granted for the region
+// served by endpoint.
+type RefreshTokenFunc func(sellerID, endpoint string) (string, error)
+
+// ClientPool lazily creates clients for many sellers. Clients for the same
+// seller and region share a token store and rate limiter; all clients share
+// one HTTP client.
+type ClientPool struct {
+	clientID      string
+	clientSecret  string
+	refreshTokens RefreshTokenFunc
+
+	httpClient    *http.Client
+	logger        *slog.Logger
+	middlewares   []Middleware
+	rateLimits    map[string]Rate
+	newTokenStore func(sellerID, endpoint string, token *oauth2.Token) TokenStore
+	maxIdle       time.Duration
+
+	mu      sync.Mutex
+	entries map[poolKey]*poolEntry
+	lookups map[poolKey]*tokenLookup
+}
+
+type poolKey struct {
+	sellerID string
+	endpoint string
+}
+
+type poolEntry struct {
+	tokens   TokenStore
+	limiter  *RateLimiter
+	clients  map[string]*Client
+	lastUsed time.Time
+}
+
+// tokenLookup is a refresh token lookup in flight. Callers for the same key
+// wait on done and share the result.
+type tokenLookup struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+type PoolOption func(*ClientPool)
+
+func WithPoolHTTPClient(hc *http.Client) PoolOption {
+	return func(p *ClientPool) {
+		p.httpClient = hc
+	}
+}
+
+func WithPoolLogger(l *slog.Logger) PoolOption {
+	return func(p *ClientPool) {
+		p.logger = l
+	}
+}
+
+// WithPoolMiddlewares adds middlewares outside the built-in chain of every
+// pooled client.
+func WithPoolMiddlewares(mws ...Middleware) PoolOption {
+	return func(p *ClientPool) {
+		p.middlewares = mws
+	}
+}
+
+func WithPoolRateLimits(limits map[string]Rate) PoolOption {
+	return func(p *ClientPool) {
+		p.rateLimits = limits
+	}
+}
+
+// WithPoolTokenStore sets how token stores are created for each seller and
+// region. The default keeps tokens in memory.
+func WithPoolTokenStore(fn func(sellerID, endpoint string, token *oauth2.Token) TokenStore) PoolOption {
+	return func(p *ClientPool) {
+		p.newTokenStore = fn
+	}
+}
+
+// WithPoolMaxIdle evicts sellers that have not been used for d. Eviction
+// runs whenever For is called.
+func WithPoolMaxIdle(d time.Duration) PoolOption {
+	return func(p *ClientPool) {
+		p.maxIdle = d
+	}
+}
+
+func NewClientPool(clientID, clientSecret string, refreshTokens RefreshTokenFunc, opts ...PoolOption) *ClientPool {
+	p := &ClientPool{
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		refreshTokens: refreshTokens,
+		rateLimits:    DefaultRateLimits,
+		newTokenStore: func(sellerID, endpoint string, token *oauth2.Token) TokenStore {
+			return NewMemoryTokenStore(token)
+		},
+		entries: map[poolKey]*poolEntry{},
+		lookups: map[poolKey]*tokenLookup{},
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.httpClient == nil {
+		p.httpClient = &http.Client{Timeout: DefaultHTTPTimeout}
+	}
+	return p
+}
+
+// For returns a ready client for sellerID in marketplace m, creating it on
+// first use. The refresh token lookup runs without holding the pool lock, so
+// a slow lookup only delays callers for the same seller and region.
+func (p *ClientPool) For(sellerID string, m Marketplace) (*Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if p.maxIdle > 0 {
+		p.evictIdle(now)
+	}
+
+	key := poolKey{sellerID: sellerID, endpoint: m.Endpoint}
+	entry, ok := p.entries[key]
+	if !ok {
+		p.mu.Unlock()
+		refreshToken, err := p.refreshToken(key)
+		p.mu.Lock()
+		if err != nil {
+			return nil, fmt.Errorf("error getting refresh token for seller %s: %w", sellerID, err)
+		}
+
+		// Another caller may have created the entry while the lock was
+		// released.
+		if entry, ok = p.entries[key]; !ok {
+			entry = &poolEntry{
+				tokens:  p.newTokenStore(sellerID, m.Endpoint, &oauth2.Token{RefreshToken: refreshToken}),
+				limiter: NewRateLimiter(p.rateLimits),
+				clients: map[string]*Client{},
+			}
+			p.entries[key] = entry
+		}
+	}
+	entry.lastUsed = now
+
+	if c, ok := entry.clients[m.ID]; ok {
+		return c, nil
+	}
+
+	c := &Client{
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		SellerID:     sellerID,
+		HTTPClient:   p.httpClient,
+		Marketplace:  &m,
+		Logger:       p.logger,
+		TokenStore:   entry.tokens,
+	}
+	c.Middlewares = append(append([]Middleware{}, p.middlewares...),
+		c.RetryMiddleware(),
+		entry.limiter,
+		c.AuthMiddleware(),
+	)
+	entry.clients[m.ID] = c
+
+	return c, nil
+}
+
+// refreshToken looks up the refresh token for key. It must be called without
+// p.mu held; concurrent calls for the same key share one lookup.
+func (p *ClientPool) refreshToken(key poolKey) (string, error) {
+	p.mu.Lock()
+	l, ok := p.lookups[key]
+	if !ok {
+		l = &tokenLookup{done: make(chan struct{})}
+		p.lookups[key] = l
+	}
+	p.mu.Unlock()
+
+	if ok {
+		<-l.done
+		return l.token, l.err
+	}
+
+	l.token, l.err = p.refreshTokens(key.sellerID, key.endpoint)
+
+	p.mu.Lock()
+	delete(p.lookups, key)
+	p.mu.Unlock()
+	close(l.done)
+
+	return l.token, l.err
+}
+
+// SetClientSecret swaps the LWA client secret for the pool and every pooled
+// client, e.g. from a Client.OnClientSecretRotated hook.
+func (p *ClientPool) SetClientSecret(secret string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.clientSecret = secret
+	for _, entry := range p.entries {
+		for _, c := range entry.clients {
+			c.SetClientSecret(secret)
+		}
+	}
+}
+
+// Evict drops every client for sellerID. The next call to For creates them
+// again, including a fresh refresh token lookup.
+func (p *ClientPool) Evict(sellerID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key := range p.entries {
+		if key.sellerID == sellerID {
+			delete(p.entries, key)
+		}
+	}
+}
+
+// EvictRegion drops the clients for sellerID in the region served by endpoint.
+func (p *ClientPool) EvictRegion(sellerID, endpoint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.entries, poolKey{sellerID: sellerID, endpoint: endpoint})
+}
+
+// Len returns the number of (seller, region) entries in the pool.
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.entries)
+}
+
+func (p *ClientPool) evictIdle(now time.Time) {
+	for key, entry := range p.entries {
+		if now.Sub(entry.lastUsed) > p.maxIdle {
+			delete(p.entries, key)
+		}
+	}
+}
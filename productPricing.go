@@ -51,16 +51,15 @@ func (c *Client) GetCompetitivePricingByASIN(ctx context.Context, asins []string
 		RawQuery: qs.Encode(),
 	}
 
-	req := request{
-		Method: http.MethodGet,
-		URL:    &u,
-		Token:  c.Token,
+	req := Request{
+		Operation:     "productPricing.getCompetitivePricing",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
 	}
 
-	res, err := c.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 1 * time.Second,
-	})
+	res, err := c.do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
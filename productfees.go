@@ -28,11 +28,6 @@ type getMyFeesEstimateItem struct {
 	FeesEstimateRequest feesEstimateRequest `json:"FeesEstimateRequest"`
 }
 
-type Money struct {
-	CurrencyCode string  `json:"CurrencyCode"`
-	Amount       float64 `json:"Amount"`
-}
-
 type GetMyFeesResponseItem struct {
 	Status                 string `json:"Status"`
 	FeesEstimateIdentifier struct {
@@ -43,19 +38,13 @@ type GetMyFeesResponseItem struct {
 		IsAmazonFulfilled     bool   `json:"IsAmazonFulfilled"`
 		IdValue               string `json:"IdValue"`
 		PriceToEstimateFees   struct {
-			ListingPrice struct {
-				CurrencyCode string  `json:"CurrencyCode"`
-				Amount       float64 `json:"Amount"`
-			} `json:"ListingPrice"`
+			ListingPrice Money `json:"ListingPrice"`
 		} `json:"PriceToEstimateFees"`
 	} `json:"FeesEstimateIdentifier"`
 	FeesEstimate struct {
 		TimeOfFeesEstimation string `json:"TimeOfFeesEstimation"`
-		TotalFeesEstimate    struct {
-			CurrencyCode string  `json:"CurrencyCode"`
-			Amount       float64 `json:"Amount"`
-		} `json:"TotalFeesEstimate"`
-		FeeDetailList []struct {
+		TotalFeesEstimate    Money  `json:"TotalFeesEstimate"`
+		FeeDetailList        []struct {
 			FeeType               string `json:"FeeType"`
 			FeeAmount             Money  `json:"FeeAmount"`
 			FinalFee              Money  `json:"FinalFee"`
@@ -72,7 +61,7 @@ type GetMyFeesResponseItem struct {
 
 type GetMyFeesItem struct {
 	ASIN     string
-	Price    float64
+	Price    Decimal
 	Currency string
 }
 
@@ -108,17 +97,16 @@ func (s *Client) GetProductFees(ctx context.Context, items []GetMyFeesItem) ([]G
 		return nil, fmt.Errorf("error marshaling request body: %w", err)
 	}
 
-	req := request{
-		Method: http.MethodPost,
-		URL:    &u,
-		Body:   body,
-		Token:  s.Token,
+	req := Request{
+		Operation:     "productFees.getMyFeesEstimates",
+		Method:        http.MethodPost,
+		URL:           &u,
+		Body:          body,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
 	}
 
-	res, err := s.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 1 * time.Second,
-	})
+	res, err := s.do(ctx, req)
 	if err != nil {
 		return nil, err
 	}
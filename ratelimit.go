@@ -0,0 +1,198 @@
+package spapi
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Rate is a token bucket limit for one operation.
+type Rate struct {
+	PerSecond float64
+	Burst     int
+}
+
+// DefaultRateLimits are the documented SP-API default usage plans for the
+// operations this package calls.
+var DefaultRateLimits = map[string]Rate{
+	"catalogItems.searchCatalogItems":                                {PerSecond: 2, Burst: 2},
+	"easyShip.createScheduledPackage":                                {PerSecond: 1, Burst: 5},
+	"easyShip.createScheduledPackageBulk":                            {PerSecond: 1, Burst: 5},
+	"easyShip.getScheduledPackage":                                   {PerSecond: 1, Burst: 5},
+	"easyShip.listHandoverSlots":                                     {PerSecond: 1, Burst: 5},
+	"easyShip.updateScheduledPackages":                               {PerSecond: 1, Burst: 5},
+	"fbaInbound.cancelInboundPlan":                                   {PerSecond: 2, Burst: 2},
+	"fbaInbound.confirmDeliveryWindowOptions":                        {PerSecond: 2, Burst: 2},
+	"fbaInbound.confirmPackingOption":                                {PerSecond: 2, Burst: 2},
+	"fbaInbound.confirmPlacementOption":                              {PerSecond: 2, Burst: 2},
+	"fbaInbound.confirmTransportationOptions":                        {PerSecond: 2, Burst: 2},
+	"fbaInbound.createInboundPlan":                                   {PerSecond: 2, Burst: 2},
+	"fbaInbound.createMarketplaceItemLabels":                         {PerSecond: 2, Burst: 2},
+	"fbaInbound.generateDeliveryWindowOptions":                       {PerSecond: 2, Burst: 2},
+	"fbaInbound.generatePackingOptions":                              {PerSecond: 2, Burst: 2},
+	"fbaInbound.generatePlacementOptions":                            {PerSecond: 2, Burst: 2},
+	"fbaInbound.generateTransportationOptions":                       {PerSecond: 2, Burst: 2},
+	"fbaInbound.getBillOfLading":                                     {PerSecond: 2, Burst: 30},
+	"fbaInbound.getInboundGuidance":                                  {PerSecond: 2, Burst: 30},
+	"fbaInbound.getInboundOperationStatus":                           {PerSecond: 2, Burst: 6},
+	"fbaInbound.getInboundPlan":                                      {PerSecond: 2, Burst: 6},
+	"fbaInbound.getItemEligibilityPreview":                           {PerSecond: 1, Burst: 1},
+	"fbaInbound.getLabels":                                           {PerSecond: 2, Burst: 30},
+	"fbaInbound.getPrepInstructions":                                 {PerSecond: 2, Burst: 30},
+	"fbaInbound.getShipment":                                         {PerSecond: 2, Burst: 6},
+	"fbaInbound.getShipmentItems":                                    {PerSecond: 2, Burst: 30},
+	"fbaInbound.getShipmentItemsByShipmentId":                        {PerSecond: 2, Burst: 30},
+	"fbaInbound.getShipments":                                        {PerSecond: 2, Burst: 30},
+	"fbaInbound.listDeliveryWindowOptions":                           {PerSecond: 2, Burst: 6},
+	"fbaInbound.listPackingGroupItems":                               {PerSecond: 2, Burst: 6},
+	"fbaInbound.listPackingOptions":                                  {PerSecond: 2, Burst: 6},
+	"fbaInbound.listPlacementOptions":                                {PerSecond: 2, Burst: 6},
+	"fbaInbound.listTransportationOptions":                           {PerSecond: 2, Burst: 6},
+	"fbaInbound.setPackingInformation":                               {PerSecond: 2, Burst: 2},
+	"fbaInventory.getInventorySummaries":                             {PerSecond: 2, Burst: 2},
+	"fbaOutbound.cancelFulfillmentOrder":                             {PerSecond: 2, Burst: 30},
+	"fbaOutbound.createFulfillmentOrder":                             {PerSecond: 2, Burst: 30},
+	"fbaOutbound.createFulfillmentReturn":                            {PerSecond: 2, Burst: 30},
+	"fbaOutbound.getFeatureInventory":                                {PerSecond: 2, Burst: 30},
+	"fbaOutbound.getFeatures":                                        {PerSecond: 2, Burst: 30},
+	"fbaOutbound.getFulfillmentOrder":                                {PerSecond: 2, Burst: 30},
+	"fbaOutbound.getFulfillmentPreview":                              {PerSecond: 2, Burst: 30},
+	"fbaOutbound.getPackageTrackingDetails":                          {PerSecond: 2, Burst: 30},
+	"fbaOutbound.listAllFulfillmentOrders":                           {PerSecond: 2, Burst: 30},
+	"fbaOutbound.listReturnReasonCodes":                              {PerSecond: 2, Burst: 30},
+	"fbaOutbound.updateFulfillmentOrder":                             {PerSecond: 2, Burst: 30},
+	"finances.listFinancialEventGroups":                              {PerSecond: 0.5, Burst: 30},
+	"finances.listFinancialEvents":                                   {PerSecond: 0.5, Burst: 30},
+	"finances.listFinancialEventsByGroupId":                          {PerSecond: 0.5, Burst: 30},
+	"finances.listFinancialEventsByOrderId":                          {PerSecond: 0.5, Burst: 30},
+	"finances.listTransactions":                                      {PerSecond: 0.5, Burst: 10},
+	"listingsRestrictions.getListingsRestrictions":                   {PerSecond: 5, Burst: 10},
+	"merchantFulfillment.cancelShipment":                             {PerSecond: 1, Burst: 1},
+	"merchantFulfillment.createShipment":                             {PerSecond: 2, Burst: 2},
+	"merchantFulfillment.getAdditionalSellerInputs":                  {PerSecond: 1, Burst: 1},
+	"merchantFulfillment.getEligibleShipmentServices":                {PerSecond: 6, Burst: 12},
+	"merchantFulfillment.getShipment":                                {PerSecond: 1, Burst: 1},
+	"notifications.createDestination":                                {PerSecond: 1, Burst: 5},
+	"notifications.createSubscription":                               {PerSecond: 1, Burst: 5},
+	"notifications.deleteDestination":                                {PerSecond: 1, Burst: 5},
+	"notifications.deleteSubscriptionById":                           {PerSecond: 1, Burst: 5},
+	"notifications.getDestination":                                   {PerSecond: 1, Burst: 5},
+	"notifications.getDestinations":                                  {PerSecond: 1, Burst: 5},
+	"notifications.getSubscription":                                  {PerSecond: 1, Burst: 5},
+	"notifications.getSubscriptionById":                              {PerSecond: 1, Burst: 5},
+	"orders.confirmShipment":                                         {PerSecond: 2, Burst: 10},
+	"orders.getOrderItems":                                           {PerSecond: 0.5, Burst: 30},
+	"orders.getOrderRegulatedInfo":                                   {PerSecond: 0.5, Burst: 30},
+	"orders.getOrders":                                               {PerSecond: 0.0167, Burst: 20},
+	"orders.updateShipmentStatus":                                    {PerSecond: 5, Burst: 15},
+	"orders.updateVerificationStatus":                                {PerSecond: 0.5, Burst: 30},
+	"productFees.getMyFeesEstimates":                                 {PerSecond: 0.5, Burst: 1},
+	"productPricing.getCompetitivePricing":                           {PerSecond: 0.5, Burst: 1},
+	"reports.createReport":                                           {PerSecond: 0.0167, Burst: 15},
+	"reports.getReport":                                              {PerSecond: 2, Burst: 15},
+	"reports.getReportDocument":                                      {PerSecond: 0.0167, Burst: 15},
+	"sellers.getAccount":                                             {PerSecond: 0.016, Burst: 15},
+	"sellers.getMarketplaceParticipations":                           {PerSecond: 0.016, Burst: 15},
+	"shipping.cancelShipment":                                        {PerSecond: 80, Burst: 100},
+	"shipping.getAccessPoints":                                       {PerSecond: 80, Burst: 100},
+	"shipping.getRates":                                              {PerSecond: 80, Burst: 100},
+	"shipping.getShipmentDocuments":                                  {PerSecond: 80, Burst: 100},
+	"shipping.getTracking":                                           {PerSecond: 80, Burst: 100},
+	"shipping.oneClickShipment":                                      {PerSecond: 80, Burst: 100},
+	"shipping.purchaseShipment":                                      {PerSecond: 80, Burst: 100},
+	"solicitations.createProductReviewAndSellerFeedbackSolicitation": {PerSecond: 1, Burst: 5},
+}
+
+type bucket struct {
+	rate   Rate
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiter is a Middleware that waits for a per-operation token before
+// letting a request through. Operations without a configured Rate are not
+// limited.
+type RateLimiter struct {
+	mu      sync.Mutex
+	limits  map[string]Rate
+	buckets map[string]*bucket
+}
+
+func NewRateLimiter(limits map[string]Rate) *RateLimiter {
+	l := &RateLimiter{
+		limits:  make(map[string]Rate, len(limits)),
+		buckets: map[string]*bucket{},
+	}
+	for op, r := range limits {
+		l.limits[op] = r
+	}
+	return l
+}
+
+// SetRate changes the limit for an operation, e.g. after reading the
+// x-amzn-RateLimit-Limit response header.
+func (l *RateLimiter) SetRate(operation string, r Rate) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limits[operation] = r
+	if b, ok := l.buckets[operation]; ok {
+		b.rate = r
+	}
+}
+
+// Wait blocks until operation may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, operation string) error {
+	l.mu.Lock()
+	r, ok := l.limits[operation]
+	if !ok || r.PerSecond <= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	b, ok := l.buckets[operation]
+	if !ok {
+		b = &bucket{rate: r, tokens: float64(r.Burst), last: now}
+		l.buckets[operation] = b
+	}
+
+	burst := float64(b.rate.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	b.tokens += now.Sub(b.last).Seconds() * b.rate.PerSecond
+	if b.tokens > burst {
+		b.tokens = burst
+	}
+	b.last = now
+
+	// reserve a token up front so concurrent callers queue behind each other.
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate.PerSecond * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	if err := sleep(ctx, wait); err != nil {
+		l.mu.Lock()
+		b.tokens++
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (l *RateLimiter) Wrap(next Handler) Handler {
+	return HandlerFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+		if err := l.Wait(ctx, req.Operation); err != nil {
+			return nil, err
+		}
+		return next.Handle(ctx, req)
+	})
+}
@@ -0,0 +1,199 @@
+package spapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type ReportProcessingStatus string
+
+var (
+	ReportProcessingStatusInQueue    ReportProcessingStatus = "IN_QUEUE"
+	ReportProcessingStatusInProgress ReportProcessingStatus = "IN_PROGRESS"
+	ReportProcessingStatusDone       ReportProcessingStatus = "DONE"
+	ReportProcessingStatusCancelled  ReportProcessingStatus = "CANCELLED"
+	ReportProcessingStatusFatal      ReportProcessingStatus = "FATAL"
+)
+
+type CreateReportRequest struct {
+	ReportType     string            `json:"reportType"`
+	MarketplaceIDs []string          `json:"marketplaceIds"`
+	ReportOptions  map[string]string `json:"reportOptions,omitempty"`
+	DataStartTime  *time.Time        `json:"dataStartTime,omitempty"`
+	DataEndTime    *time.Time        `json:"dataEndTime,omitempty"`
+}
+
+type Report struct {
+	ReportID         string                 `json:"reportId"`
+	ReportType       string                 `json:"reportType"`
+	MarketplaceIDs   []string               `json:"marketplaceIds"`
+	DataStartTime    *time.Time             `json:"dataStartTime"`
+	DataEndTime      *time.Time             `json:"dataEndTime"`
+	CreatedTime      time.Time              `json:"createdTime"`
+	ProcessingStatus ReportProcessingStatus `json:"processingStatus"`
+	ProcessingStart  *time.Time             `json:"processingStartTime"`
+	ProcessingEnd    *time.Time             `json:"processingEndTime"`
+	ReportDocumentID string                 `json:"reportDocumentId"`
+}
+
+type ReportDocument struct {
+	ReportDocumentID     string `json:"reportDocumentId"`
+	URL                  string `json:"url"`
+	CompressionAlgorithm string `json:"compressionAlgorithm"` // GZIP
+}
+
+// ReportError is returned by WaitForReport when processing ends without a
+// document.
+type ReportError struct {
+	ReportID string
+	Status   ReportProcessingStatus
+}
+
+func (e *ReportError) Error() string {
+	return fmt.Sprintf("spapi: report %s finished with status %s", e.ReportID, e.Status)
+}
+
+func (s *Client) reportsRequest(ctx context.Context, operation, method, path string, body, out any) error {
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+	}
+	setEscapedPath(&u, "/reports/2021-06-30"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		req.Body = b
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// CreateReport requests a report and returns its ID. MarketplaceIDs defaults
+// to the client's marketplace.
+func (s *Client) CreateReport(ctx context.Context, req CreateReportRequest) (string, error) {
+	if len(req.MarketplaceIDs) == 0 {
+		req.MarketplaceIDs = []string{s.Marketplace.ID}
+	}
+
+	var resp struct {
+		ReportID string `json:"reportId"`
+	}
+	if err := s.reportsRequest(ctx, "reports.createReport", http.MethodPost, "/reports", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.ReportID, nil
+}
+
+func (s *Client) GetReport(ctx context.Context, reportID string) (*Report, error) {
+	var resp Report
+	if err := s.reportsRequest(ctx, "reports.getReport", http.MethodGet, "/reports/"+url.PathEscape(reportID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) GetReportDocument(ctx context.Context, reportDocumentID string) (*ReportDocument, error) {
+	var resp ReportDocument
+	if err := s.reportsRequest(ctx, "reports.getReportDocument", http.MethodGet, "/documents/"+url.PathEscape(reportDocumentID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WaitForReport polls the report until it is DONE. CANCELLED and FATAL
+// reports return a *ReportError.
+func (s *Client) WaitForReport(ctx context.Context, reportID string, interval time.Duration) (*Report, error) {
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+
+	for {
+		report, err := s.GetReport(ctx, reportID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch report.ProcessingStatus {
+		case ReportProcessingStatusDone:
+			return report, nil
+		case ReportProcessingStatusCancelled, ReportProcessingStatusFatal:
+			return report, &ReportError{ReportID: reportID, Status: report.ProcessingStatus}
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// DownloadReportDocument fetches the document from its pre-signed URL and
+// decompresses it when needed.
+func (s *Client) DownloadReportDocument(ctx context.Context, doc *ReportDocument) ([]byte, error) {
+	b, err := s.download(ctx, doc.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.CompressionAlgorithm == "GZIP" {
+		zr, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing report document: %w", err)
+		}
+		defer zr.Close()
+
+		if b, err = io.ReadAll(zr); err != nil {
+			return nil, fmt.Errorf("error decompressing report document: %w", err)
+		}
+	}
+	return b, nil
+}
+
+// download fetches a pre-signed document URL. These URLs carry their own
+// authorization, so the middleware chain is skipped.
+func (s *Client) download(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading document: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("spapi: document download failed with status %d", res.StatusCode)
+	}
+	return b, nil
+}
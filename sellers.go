@@ -0,0 +1,167 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type SellerMarketplaceInfo struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	CountryCode         string `json:"countryCode"`
+	DefaultCurrencyCode string `json:"defaultCurrencyCode"`
+	DefaultLanguageCode string `json:"defaultLanguageCode"`
+	DomainName          string `json:"domainName"`
+}
+
+type Participation struct {
+	IsParticipating      bool `json:"isParticipating"`
+	HasSuspendedListings bool `json:"hasSuspendedListings"`
+}
+
+type MarketplaceParticipation struct {
+	Marketplace   SellerMarketplaceInfo `json:"marketplace"`
+	Participation Participation         `json:"participation"`
+	StoreName     string                `json:"storeName"`
+}
+
+type SellerAddress struct {
+	AddressLine1        string `json:"addressLine1"`
+	AddressLine2        string `json:"addressLine2"`
+	AddressLine3        string `json:"addressLine3"`
+	City                string `json:"city"`
+	County              string `json:"county"`
+	District            string `json:"district"`
+	StateOrProvinceCode string `json:"stateOrProvinceCode"`
+	PostalCode          string `json:"postalCode"`
+	CountryCode         string `json:"countryCode"`
+}
+
+type SellerBusiness struct {
+	Name                           string        `json:"name"`
+	RegisteredBusinessAddress      SellerAddress `json:"registeredBusinessAddress"`
+	CompanyRegistrationNumber      string        `json:"companyRegistrationNumber"`
+	CompanyTaxIdentificationNumber string        `json:"companyTaxIdentificationNumber"`
+	NonLatinName                   string        `json:"nonLatinName"`
+}
+
+type SellerPrimaryContact struct {
+	Name         string        `json:"name"`
+	Address      SellerAddress `json:"address"`
+	NonLatinName string        `json:"nonLatinName"`
+}
+
+type SellerAccount struct {
+	MarketplaceParticipationList []MarketplaceParticipation `json:"marketplaceParticipationList"`
+	BusinessType                 string                     `json:"businessType"` // CHARITY, CRAFTSMAN, NATURAL_PERSON_COMPANY, PUBLIC_LISTED, PRIVATE_LIMITED, SOLE_PROPRIETORSHIP, STATE_OWNED, INDIVIDUAL
+	SellingPlan                  string                     `json:"sellingPlan"`  // PROFESSIONAL, INDIVIDUAL
+	Business                     *SellerBusiness            `json:"business"`
+	PrimaryContact               *SellerPrimaryContact      `json:"primaryContact"`
+}
+
+func (s *Client) GetMarketplaceParticipations(ctx context.Context) ([]MarketplaceParticipation, error) {
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+		Path:   "/sellers/v1/marketplaceParticipations",
+	}
+
+	req := Request{
+		Operation:     "sellers.getMarketplaceParticipations",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Minute,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var resp struct {
+		Payload []MarketplaceParticipation `json:"payload"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("error decoding spapi response: %w", err)
+	}
+
+	return resp.Payload, nil
+}
+
+func (s *Client) GetAccount(ctx context.Context) (*SellerAccount, error) {
+	u := url.URL{
+		Scheme: "https",
+		Host:   s.Marketplace.Endpoint,
+		Path:   "/sellers/v1/account",
+	}
+
+	req := Request{
+		Operation:     "sellers.getAccount",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Minute,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var resp struct {
+		Payload SellerAccount `json:"payload"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("error decoding spapi response: %w", err)
+	}
+
+	return &resp.Payload, nil
+}
+
+type SellerMarketplace struct {
+	Marketplace          Marketplace
+	StoreName            string
+	HasSuspendedListings bool
+}
+
+// ParticipatingMarketplaces returns the registry marketplaces the seller
+// participates in within the client's region. Marketplaces that are not in
+// the registry, such as non-Amazon channels, are skipped.
+func (s *Client) ParticipatingMarketplaces(ctx context.Context) ([]SellerMarketplace, error) {
+	participations, err := s.GetMarketplaceParticipations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []SellerMarketplace
+	for _, p := range participations {
+		if !p.Participation.IsParticipating {
+			continue
+		}
+
+		m, ok := MarketplaceByID(p.Marketplace.ID)
+		if !ok {
+			s.logger().LogAttrs(ctx, slog.LevelDebug, "skipping unknown marketplace",
+				slog.String("marketplace_id", p.Marketplace.ID),
+				slog.String("name", p.Marketplace.Name),
+			)
+			continue
+		}
+
+		ms = append(ms, SellerMarketplace{
+			Marketplace:          m,
+			StoreName:            p.StoreName,
+			HasSuspendedListings: p.Participation.HasSuspendedListings,
+		})
+	}
+
+	return ms, nil
+}
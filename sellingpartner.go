@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -22,10 +24,57 @@ type Client struct {
 	Token        *oauth2.Token
 	HTTPClient   *http.Client
 	Marketplace  *Marketplace
+	Logger       *slog.Logger
+
+	// ShippingBusinessID is sent as x-amzn-shipping-business-id on Amazon
+	// Shipping calls, e.g. AmazonShipping_US. Amazon's default applies when
+	// it is empty.
+	ShippingBusinessID string
+
+	// TokenStore, when set, shares tokens between clients. Token is loaded
+	// from it before each refresh check and stored after each refresh.
+	TokenStore TokenStore
+
+	// OnClientSecretRotated is called with a new LWA client secret before the
+	// client switches to it, so it can be persisted.
+	OnClientSecretRotated func(ctx context.Context, r ClientSecretRotation) error
+	// OnClientSecretExpiry is called when Amazon warns that the current
+	// secret is about to expire, e.g. to call RotateApplicationClientSecret.
+	OnClientSecretExpiry func(ctx context.Context, e ClientSecretExpiry) error
+
+	// Middlewares wrap every operation, outermost first. When nil the
+	// client uses DefaultMiddlewares. Set it before the first request; use
+	// Use to add middlewares later.
+	Middlewares []Middleware
+
+	defaultsOnce  sync.Once
+	defaults      []Middleware
+	mwMu          sync.RWMutex
+	tokenMu       sync.Mutex
+	credMu        sync.RWMutex
+	grantlessOnce sync.Once
+	grantless     *GrantlessTokenProvider
 }
 
-func NewClient() *Client {
-	return &Client{}
+// NewClient applies opts in order and validates the result. HTTPClient
+// defaults to a client with DefaultHTTPTimeout.
+func NewClient(opts ...Option) (*Client, error) {
+	c := &Client{}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: DefaultHTTPTimeout}
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
 }
 
 var (
@@ -41,24 +90,72 @@ type amazonToken struct {
 	TokenType    string `json:"token_type"`
 }
 
-func (s *Client) refreshToken(ctx context.Context) (*oauth2.Token, error) {
-	if !s.Token.Expiry.IsZero() && s.Token.Expiry.After(time.Now()) {
-		return s.Token, nil
+// refreshToken returns a valid access token, refreshing it when it has
+// expired or force is set. refreshed reports whether a new token was fetched.
+func (s *Client) refreshToken(ctx context.Context, force bool) (token *oauth2.Token, refreshed bool, err error) {
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+
+	if s.TokenStore != nil {
+		stored, err := s.TokenStore.Load(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("error loading token: %w", err)
+		}
+		if stored != nil && (s.Token == nil || stored.Expiry.After(s.Token.Expiry)) {
+			s.Token = stored
+		}
+	}
+	if s.Token == nil {
+		return nil, false, ErrMissingRefreshToken
+	}
+
+	if !force && !s.Token.Expiry.IsZero() && s.Token.Expiry.After(time.Now()) {
+		return s.Token, false, nil
 	}
 
 	body := url.Values{}
 	body.Set("grant_type", "refresh_token")
 	body.Set("refresh_token", s.Token.RefreshToken)
-	body.Set("client_id", s.ClientID)
-	body.Set("client_secret", s.ClientSecret)
+	clientID, clientSecret := s.credentials()
+	body.Set("client_id", clientID)
+	body.Set("client_secret", clientSecret)
+
+	token, err = requestLWAToken(ctx, s.HTTPClient, body)
+	if err != nil {
+		return nil, false, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.Token.RefreshToken
+	}
+
+	s.Token = token
+	if s.TokenStore != nil {
+		if err := s.TokenStore.Store(ctx, s.Token); err != nil {
+			return nil, false, fmt.Errorf("error storing token: %w", err)
+		}
+	}
 
-	req, err := http.NewRequest(http.MethodPost, amazon.Endpoint.TokenURL, strings.NewReader(body.Encode()))
+	s.logger().LogAttrs(ctx, slog.LevelInfo, "refreshed LWA access token",
+		slog.String("client_id", s.ClientID),
+		slog.Time("expiry", s.Token.Expiry),
+	)
+
+	return s.Token, true, nil
+}
+
+// requestLWAToken posts form to the LWA token endpoint.
+func requestLWAToken(ctx context.Context, hc *http.Client, form url.Values) (*oauth2.Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, amazon.Endpoint.TokenURL, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("error creating token request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	res, err := s.HTTPClient.Do(req)
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+
+	res, err := hc.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error refreshing tokens: %w", err)
 	}
@@ -72,6 +169,7 @@ func (s *Client) refreshToken(ctx context.Context) (*oauth2.Token, error) {
 		}
 
 		_ = json.Unmarshal(b, &spapiErr)
+		spapiErr.Body = b
 		spapiErr.StatusCode = res.StatusCode
 		spapiErr.URL = req.URL
 		return nil, spapiErr
@@ -82,102 +180,89 @@ func (s *Client) refreshToken(ctx context.Context) (*oauth2.Token, error) {
 		return nil, fmt.Errorf("error decoding token response: %w", err)
 	}
 
-	s.Token = &oauth2.Token{
+	return &oauth2.Token{
 		AccessToken:  tr.AccessToken,
 		TokenType:    tr.TokenType,
 		RefreshToken: tr.RefreshToken,
 		Expiry:       time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second),
-	}
-
-	return s.Token, nil
+	}, nil
 }
 
-type retryOptions struct {
-	retryLimit    int
-	sleepDuration time.Duration
+type Request struct {
+	Operation  string
+	Method     string
+	URL        *url.URL
+	Header     http.Header
+	Body       []byte
+	Token      *oauth2.Token
+	RetryLimit int
+
+	// Scope requests a grantless token for this operation. Operations
+	// listed in GrantlessScopes get one automatically.
+	Scope string
+
+	SleepDuration time.Duration
+
+	// Set by the built-in middlewares as the operation runs.
+	Attempt        int
+	ThrottleWait   time.Duration
+	TokenRefreshes int
 }
 
-func (s *Client) retry(ctx context.Context, req request, opts retryOptions) (*http.Response, error) {
-	token, err := s.refreshToken(ctx)
+// setEscapedPath sets u's path from an already escaped path, so IDs passed
+// through url.PathEscape are sent as-is instead of being escaped twice.
+func setEscapedPath(u *url.URL, escaped string) {
+	p, err := url.PathUnescape(escaped)
 	if err != nil {
-		return nil, err
+		u.Path, u.RawPath = escaped, ""
+		return
 	}
+	u.Path, u.RawPath = p, escaped
+}
 
-	if opts.sleepDuration == 0 {
-		opts.sleepDuration = 1 * time.Second
+// do runs req through the client's middleware chain and sends it.
+func (s *Client) do(ctx context.Context, req Request) (*http.Response, error) {
+	if req.Header == nil {
+		req.Header = http.Header{}
 	}
 
-	var spapiErr Error
-
-	for i := 0; i < opts.retryLimit; i++ {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		body := bytes.NewReader(req.Body)
-		request, err := http.NewRequestWithContext(ctx, req.Method, req.URL.String(), body)
-		if err != nil {
-			return nil, err
-		}
-		request.Header.Set("x-amz-access-token", token.AccessToken)
-
-		res, err := s.HTTPClient.Do(request)
-		if err != nil {
-			return nil, err
-		}
-
-		if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
-			return res, nil
-		}
+	return chain(s.middlewares(), s.send).Handle(ctx, &req)
+}
 
-		b, err := io.ReadAll(res.Body)
-		if err != nil {
-			return nil, fmt.Errorf("error reading spapi response body: %w", err)
-		}
+// send performs a single HTTP attempt. Non-2xx responses are returned as Error.
+func (s *Client) send(ctx context.Context, req *Request) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, req.Method, req.URL.String(), bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range req.Header {
+		request.Header[k] = v
+	}
+	if len(req.Body) > 0 && request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
 
-		_ = json.Unmarshal(b, &spapiErr)
-		spapiErr.StatusCode = res.StatusCode
-		spapiErr.URL = req.URL
+	res, err := s.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
 
-		switch res.StatusCode {
-		case http.StatusUnauthorized:
-			// only one retry for unauthorized because the new token is valid since it was just refreshed.
-			if spapiErr.StatusCode == http.StatusUnauthorized {
-				return nil, spapiErr
-			}
-
-			creds, err := s.refreshToken(ctx)
-			if err != nil {
-				return nil, err
-			}
-			req.Token = creds
-		case http.StatusTooManyRequests:
-			if spapiErr.Errors[0].Code == "QuotaExceeded" {
-				i = 0
-				time.Sleep(30 * time.Second)
-				continue
-			}
-			time.Sleep(opts.sleepDuration)
-			continue
-		default:
-			return nil, spapiErr
-		}
+	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+		return res, nil
 	}
+	defer res.Body.Close()
 
-	return nil, RetryError{
-		RetryCount:    opts.retryLimit,
-		Err:           spapiErr,
-		SleepDuration: opts.sleepDuration,
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading spapi response body: %w", err)
 	}
-}
 
-type request struct {
-	Token  *oauth2.Token
-	Method string
-	URL    *url.URL
-	Body   []byte
+	var spapiErr Error
+	_ = json.Unmarshal(b, &spapiErr)
+	spapiErr.Body = b
+	spapiErr.StatusCode = res.StatusCode
+	spapiErr.URL = req.URL
+	return nil, spapiErr
 }
 
 type ResponseError struct {
@@ -203,7 +288,7 @@ func (e Error) Error() string {
 	if errMsg == "" {
 		errMsg = fmt.Sprintf("%s %s", e.Msg, e.Description)
 	}
-	return fmt.Sprintf("SPAPI API Error (Endpoint: %s - Status Code: %v) - Body: %s -- Message: %s", e.URL.String(), e.StatusCode, string(e.Body), errMsg)
+	return fmt.Sprintf("SPAPI API Error (Endpoint: %s - Status Code: %v) - Body: %s -- Message: %s", e.URL.String(), e.StatusCode, redactBody(e.Body), redactString(errMsg))
 }
 
 type RetryError struct {
@@ -215,3 +300,7 @@ type RetryError struct {
 func (e RetryError) Error() string {
 	return fmt.Sprintf("SPAPI Retry Error (Retry Count: %v, Sleep Duration: %v): %s", e.RetryCount, e.SleepDuration, e.Err.Error())
 }
+
+func (e RetryError) Unwrap() error {
+	return e.Err
+}
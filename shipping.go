@@ -0,0 +1,536 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ShippingAddress struct {
+	Name          string `json:"name"`
+	AddressLine1  string `json:"addressLine1"`
+	AddressLine2  string `json:"addressLine2,omitempty"`
+	AddressLine3  string `json:"addressLine3,omitempty"`
+	CompanyName   string `json:"companyName,omitempty"`
+	StateOrRegion string `json:"stateOrRegion"`
+	City          string `json:"city"`
+	CountryCode   string `json:"countryCode"`
+	PostalCode    string `json:"postalCode"`
+	Email         string `json:"email,omitempty"`
+	PhoneNumber   string `json:"phoneNumber,omitempty"`
+}
+
+type ShippingDimensions struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Unit   string  `json:"unit"` // INCH, CENTIMETER
+}
+
+type ShippingWeight struct {
+	Unit  string  `json:"unit"` // GRAM, KILOGRAM, OUNCE, POUND
+	Value float64 `json:"value"`
+}
+
+// shippingMoney is the {value, unit} form Amazon Shipping uses for amounts.
+type shippingMoney struct {
+	Value Decimal `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+func (m *shippingMoney) money() *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{CurrencyCode: m.Unit, Amount: m.Value}
+}
+
+func toShippingMoney(m *Money) *shippingMoney {
+	if m == nil {
+		return nil
+	}
+	return &shippingMoney{Value: m.Amount, Unit: m.CurrencyCode}
+}
+
+type ShippingItem struct {
+	ItemValue      *Money          `json:"-"`
+	Description    string          `json:"description,omitempty"`
+	ItemIdentifier string          `json:"itemIdentifier,omitempty"`
+	Quantity       int             `json:"quantity"`
+	Weight         *ShippingWeight `json:"weight,omitempty"`
+	IsHazmat       bool            `json:"isHazmat,omitempty"`
+	ProductType    string          `json:"productType,omitempty"`
+	SerialNumbers  []string        `json:"serialNumbers,omitempty"`
+}
+
+func (i ShippingItem) MarshalJSON() ([]byte, error) {
+	type alias ShippingItem
+	return json.Marshal(struct {
+		alias
+		ItemValue *shippingMoney `json:"itemValue,omitempty"`
+	}{alias: alias(i), ItemValue: toShippingMoney(i.ItemValue)})
+}
+
+type ShippingPackage struct {
+	Dimensions               ShippingDimensions `json:"dimensions"`
+	Weight                   ShippingWeight     `json:"weight"`
+	InsuredValue             Money              `json:"-"`
+	IsHazmat                 bool               `json:"isHazmat,omitempty"`
+	SellerDisplayName        string             `json:"sellerDisplayName,omitempty"`
+	PackageClientReferenceID string             `json:"packageClientReferenceId"`
+	Items                    []ShippingItem     `json:"items"`
+}
+
+func (p ShippingPackage) MarshalJSON() ([]byte, error) {
+	type alias ShippingPackage
+	return json.Marshal(struct {
+		alias
+		InsuredValue *shippingMoney `json:"insuredValue"`
+	}{alias: alias(p), InsuredValue: toShippingMoney(&p.InsuredValue)})
+}
+
+type ChannelType string
+
+var (
+	ChannelTypeAmazon   ChannelType = "AMAZON"
+	ChannelTypeExternal ChannelType = "EXTERNAL"
+)
+
+// ChannelDetails identifies the sales channel. AmazonOrderID is only used
+// with ChannelTypeAmazon.
+type ChannelDetails struct {
+	ChannelType   ChannelType
+	AmazonOrderID string
+}
+
+func (c ChannelDetails) MarshalJSON() ([]byte, error) {
+	v := struct {
+		ChannelType        ChannelType `json:"channelType"`
+		AmazonOrderDetails *struct {
+			OrderID string `json:"orderId"`
+		} `json:"amazonOrderDetails,omitempty"`
+	}{ChannelType: c.ChannelType}
+	if c.AmazonOrderID != "" {
+		v.AmazonOrderDetails = &struct {
+			OrderID string `json:"orderId"`
+		}{OrderID: c.AmazonOrderID}
+	}
+	return json.Marshal(v)
+}
+
+type GetRatesRequest struct {
+	ShipTo         *ShippingAddress  `json:"shipTo,omitempty"`
+	ShipFrom       ShippingAddress   `json:"shipFrom"`
+	ReturnTo       *ShippingAddress  `json:"returnTo,omitempty"`
+	ShipDate       *time.Time        `json:"shipDate,omitempty"`
+	Packages       []ShippingPackage `json:"packages"`
+	ChannelDetails ChannelDetails    `json:"channelDetails"`
+}
+
+type TimeWindow struct {
+	Start *time.Time `json:"start"`
+	End   *time.Time `json:"end"`
+}
+
+type ShippingPromise struct {
+	DeliveryWindow TimeWindow `json:"deliveryWindow"`
+	PickupWindow   TimeWindow `json:"pickupWindow"`
+}
+
+type DocumentSize struct {
+	Width  float64 `json:"width"`
+	Length float64 `json:"length"`
+	Unit   string  `json:"unit"` // INCH, CENTIMETER
+}
+
+type SupportedDocumentSpecification struct {
+	Format       LabelFormat  `json:"format"`
+	Size         DocumentSize `json:"size"`
+	PrintOptions []struct {
+		SupportedDPIs               []int    `json:"supportedDPIs"`
+		SupportedPageLayouts        []string `json:"supportedPageLayouts"`
+		SupportedFileJoiningOptions []bool   `json:"supportedFileJoiningOptions"`
+		SupportedDocumentDetails    []struct {
+			Name        string `json:"name"`
+			IsMandatory bool   `json:"isMandatory"`
+		} `json:"supportedDocumentDetails"`
+	} `json:"printOptions"`
+}
+
+type ShippingRate struct {
+	RateID                          string                           `json:"rateId"`
+	CarrierID                       string                           `json:"carrierId"`
+	CarrierName                     string                           `json:"carrierName"`
+	ServiceID                       string                           `json:"serviceId"`
+	ServiceName                     string                           `json:"serviceName"`
+	BilledWeight                    *ShippingWeight                  `json:"billedWeight"`
+	TotalCharge                     Money                            `json:"totalCharge"`
+	Promise                         ShippingPromise                  `json:"promise"`
+	SupportedDocumentSpecifications []SupportedDocumentSpecification `json:"supportedDocumentSpecifications"`
+	RequiresAdditionalInputs        bool                             `json:"requiresAdditionalInputs"`
+	PaymentType                     string                           `json:"paymentType"`
+}
+
+func (r *ShippingRate) UnmarshalJSON(b []byte) error {
+	type alias ShippingRate
+	var v struct {
+		*alias
+		TotalCharge shippingMoney `json:"totalCharge"`
+	}
+	v.alias = (*alias)(r)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.TotalCharge = *v.TotalCharge.money()
+	return nil
+}
+
+// Supports reports whether the rate can produce a label in format.
+func (r *ShippingRate) Supports(format LabelFormat) bool {
+	for _, spec := range r.SupportedDocumentSpecifications {
+		if spec.Format == format {
+			return true
+		}
+	}
+	return false
+}
+
+type IneligibleRate struct {
+	ServiceID            string `json:"serviceId"`
+	ServiceName          string `json:"serviceName"`
+	CarrierName          string `json:"carrierName"`
+	CarrierID            string `json:"carrierId"`
+	IneligibilityReasons []struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"ineligibilityReasons"`
+}
+
+type ShippingRates struct {
+	RequestToken    string           `json:"requestToken"`
+	Rates           []ShippingRate   `json:"rates"`
+	IneligibleRates []IneligibleRate `json:"ineligibleRates"`
+}
+
+// Cheapest returns the lowest-priced rate, or nil if there are none.
+func (r *ShippingRates) Cheapest() *ShippingRate {
+	var best *ShippingRate
+	for i := range r.Rates {
+		rate := &r.Rates[i]
+		if best == nil || rate.TotalCharge.Amount.Cmp(best.TotalCharge.Amount) < 0 {
+			best = rate
+		}
+	}
+	return best
+}
+
+type RequestedDocumentSpecification struct {
+	Format                 LabelFormat  `json:"format"`
+	Size                   DocumentSize `json:"size"`
+	DPI                    int          `json:"dpi,omitempty"`
+	PageLayout             string       `json:"pageLayout,omitempty"` // DEFAULT, LEFT, RIGHT
+	NeedFileJoining        bool         `json:"needFileJoining"`
+	RequestedDocumentTypes []string     `json:"requestedDocumentTypes"` // LABEL, RECEIPT, CUSTOM_FORM
+}
+
+type PurchaseShipmentRequest struct {
+	RequestToken                   string                         `json:"requestToken"`
+	RateID                         string                         `json:"rateId"`
+	RequestedDocumentSpecification RequestedDocumentSpecification `json:"requestedDocumentSpecification"`
+}
+
+type PackageDocument struct {
+	Type     string      `json:"type"`
+	Format   LabelFormat `json:"format"`
+	Contents string      `json:"contents"`
+}
+
+// Decode returns the document as raw PDF, PNG or ZPL bytes.
+func (d *PackageDocument) Decode() ([]byte, LabelFormat, error) {
+	b, format, err := DecodeLabel(d.Contents)
+	if err != nil {
+		return nil, "", err
+	}
+	if format == "" {
+		format = d.Format
+	}
+	return b, format, nil
+}
+
+type PackageDocumentDetail struct {
+	PackageClientReferenceID string            `json:"packageClientReferenceId"`
+	PackageDocuments         []PackageDocument `json:"packageDocuments"`
+	TrackingID               string            `json:"trackingId"`
+}
+
+// Label returns the package's LABEL document, or nil.
+func (d *PackageDocumentDetail) Label() *PackageDocument {
+	for i := range d.PackageDocuments {
+		if d.PackageDocuments[i].Type == "LABEL" {
+			return &d.PackageDocuments[i]
+		}
+	}
+	return nil
+}
+
+type PurchasedShipment struct {
+	ShipmentID             string                  `json:"shipmentId"`
+	PackageDocumentDetails []PackageDocumentDetail `json:"packageDocumentDetails"`
+	Promise                ShippingPromise         `json:"promise"`
+	Carrier                *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"carrier"`
+	Service *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"service"`
+	TotalCharge *Money `json:"totalCharge"`
+}
+
+func (p *PurchasedShipment) UnmarshalJSON(b []byte) error {
+	type alias PurchasedShipment
+	var v struct {
+		*alias
+		TotalCharge *shippingMoney `json:"totalCharge"`
+	}
+	v.alias = (*alias)(p)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	p.TotalCharge = v.TotalCharge.money()
+	return nil
+}
+
+// OneClickShipmentRequest rates and purchases in a single call. ServiceIDs
+// restricts the services Amazon may choose from.
+type OneClickShipmentRequest struct {
+	ShipTo              *ShippingAddress               `json:"shipTo,omitempty"`
+	ShipFrom            ShippingAddress                `json:"shipFrom"`
+	ReturnTo            *ShippingAddress               `json:"returnTo,omitempty"`
+	ShipDate            *time.Time                     `json:"shipDate,omitempty"`
+	Packages            []ShippingPackage              `json:"packages"`
+	ChannelDetails      ChannelDetails                 `json:"channelDetails"`
+	LabelSpecifications RequestedDocumentSpecification `json:"labelSpecifications"`
+	ServiceIDs          []string                       `json:"-"`
+}
+
+func (r OneClickShipmentRequest) MarshalJSON() ([]byte, error) {
+	type alias OneClickShipmentRequest
+	return json.Marshal(struct {
+		alias
+		ServiceSelection struct {
+			ServiceID []string `json:"serviceId"`
+		} `json:"serviceSelection"`
+	}{alias: alias(r), ServiceSelection: struct {
+		ServiceID []string `json:"serviceId"`
+	}{ServiceID: r.ServiceIDs}})
+}
+
+type ShippingTrackingEvent struct {
+	EventCode string    `json:"eventCode"`
+	EventTime time.Time `json:"eventTime"`
+	Location  *struct {
+		StateOrRegion string `json:"stateOrRegion"`
+		City          string `json:"city"`
+		CountryCode   string `json:"countryCode"`
+		PostalCode    string `json:"postalCode"`
+	} `json:"location"`
+	ShipmentType string `json:"shipmentType"` // FORWARD, RETURNS
+}
+
+type ShippingTracking struct {
+	TrackingID             string                  `json:"trackingId"`
+	AlternateLegTrackingID string                  `json:"alternateLegTrackingId"`
+	EventHistory           []ShippingTrackingEvent `json:"eventHistory"`
+	PromisedDeliveryDate   *time.Time              `json:"promisedDeliveryDate"`
+	Summary                struct {
+		Status string `json:"status"` // PreTransit, InTransit, Delivered, Lost, OutForDelivery, Rejected, Undeliverable, DeliveryAttempted, PickupCancelled
+	} `json:"summary"`
+}
+
+type AccessPointType string
+
+var (
+	AccessPointTypeHelix        AccessPointType = "HELIX"
+	AccessPointTypeCampusLocker AccessPointType = "CAMPUS_LOCKER"
+	AccessPointTypeOmniLocker   AccessPointType = "OMNI_LOCKER"
+	AccessPointTypeOdinLocker   AccessPointType = "ODIN_LOCKER"
+	AccessPointTypeDobbyLocker  AccessPointType = "DOBBY_LOCKER"
+	AccessPointTypeCoreLocker   AccessPointType = "CORE_LOCKER"
+	AccessPointTypeThirdParty   AccessPointType = "3P"
+	AccessPointTypeCampusRoom   AccessPointType = "CAMPUS_ROOM"
+)
+
+type AccessPointHours struct {
+	OpeningTime *struct {
+		HourOfDay    int `json:"hourOfDay"`
+		MinuteOfHour int `json:"minuteOfHour"`
+	} `json:"openingTime"`
+	ClosingTime *struct {
+		HourOfDay    int `json:"hourOfDay"`
+		MinuteOfHour int `json:"minuteOfHour"`
+	} `json:"closingTime"`
+}
+
+type AccessPoint struct {
+	AccessPointID           string          `json:"accessPointId"`
+	Name                    string          `json:"name"`
+	Timezone                string          `json:"timezone"`
+	Type                    AccessPointType `json:"type"`
+	AccessibilityAttributes struct {
+		Distance  string `json:"distance"`
+		DriveTime int    `json:"driveTime"`
+	} `json:"accessibilityAttributes"`
+	Address                ShippingAddress             `json:"address"`
+	AssistanceType         string                      `json:"assistanceType"`
+	Score                  string                      `json:"score"`
+	StandardOperatingHours map[string]AccessPointHours `json:"standardOperatingHours"`
+}
+
+func (s *Client) shippingRequest(ctx context.Context, operation, method, path string, query url.Values, body, out any) error {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		RawQuery: query.Encode(),
+	}
+	setEscapedPath(&u, "/shipping/v2"+path)
+
+	req := Request{
+		Operation:     operation,
+		Method:        method,
+		URL:           &u,
+		Header:        http.Header{},
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
+	}
+	if s.ShippingBusinessID != "" {
+		req.Header.Set("x-amzn-shipping-business-id", s.ShippingBusinessID)
+	}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		req.Body = b
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+	resp := struct {
+		Payload any `json:"payload"`
+	}{Payload: out}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return nil
+}
+
+// GetRates returns the rates for a shipment. The RequestToken of the result
+// is needed by PurchaseShipment.
+func (s *Client) GetRates(ctx context.Context, req GetRatesRequest) (*ShippingRates, error) {
+	var resp ShippingRates
+	if err := s.shippingRequest(ctx, "shipping.getRates", http.MethodPost, "/shipments/rates", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) PurchaseShipment(ctx context.Context, req PurchaseShipmentRequest) (*PurchasedShipment, error) {
+	var resp PurchasedShipment
+	if err := s.shippingRequest(ctx, "shipping.purchaseShipment", http.MethodPost, "/shipments", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// OneClickShipment requires at least one service ID.
+func (s *Client) OneClickShipment(ctx context.Context, req OneClickShipmentRequest) (*PurchasedShipment, error) {
+	if len(req.ServiceIDs) == 0 {
+		return nil, fmt.Errorf("ServiceIDs is required")
+	}
+
+	var resp PurchasedShipment
+	if err := s.shippingRequest(ctx, "shipping.oneClickShipment", http.MethodPost, "/oneClickShipment", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *Client) GetTracking(ctx context.Context, trackingID, carrierID string) (*ShippingTracking, error) {
+	query := url.Values{}
+	query.Set("trackingId", trackingID)
+	query.Set("carrierId", carrierID)
+
+	var resp ShippingTracking
+	if err := s.shippingRequest(ctx, "shipping.getTracking", http.MethodGet, "/tracking", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetShipmentDocuments re-fetches the documents of one package. dpi is only
+// sent when positive.
+func (s *Client) GetShipmentDocuments(ctx context.Context, shipmentID, packageClientReferenceID string, format LabelFormat, dpi int) (*PackageDocumentDetail, error) {
+	query := url.Values{}
+	query.Set("packageClientReferenceId", packageClientReferenceID)
+	if format != "" {
+		query.Set("format", string(format))
+	}
+	if dpi > 0 {
+		query.Set("dpi", strconv.Itoa(dpi))
+	}
+
+	var resp struct {
+		ShipmentID            string                `json:"shipmentId"`
+		PackageDocumentDetail PackageDocumentDetail `json:"packageDocumentDetail"`
+	}
+	path := "/shipments/" + url.PathEscape(shipmentID) + "/documents"
+	if err := s.shippingRequest(ctx, "shipping.getShipmentDocuments", http.MethodGet, path, query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.PackageDocumentDetail, nil
+}
+
+func (s *Client) CancelShippingShipment(ctx context.Context, shipmentID string) error {
+	path := "/shipments/" + url.PathEscape(shipmentID) + "/cancel"
+	return s.shippingRequest(ctx, "shipping.cancelShipment", http.MethodPut, path, nil, nil, nil)
+}
+
+// GetAccessPoints lists pickup points of types near postalCode, keyed by
+// type.
+func (s *Client) GetAccessPoints(ctx context.Context, countryCode, postalCode string, types ...AccessPointType) (map[AccessPointType][]AccessPoint, error) {
+	if len(types) == 0 {
+		return nil, fmt.Errorf("at least one access point type is required")
+	}
+
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+
+	query := url.Values{}
+	query.Set("accessPointTypes", strings.Join(names, ","))
+	query.Set("countryCode", countryCode)
+	query.Set("postalCode", postalCode)
+
+	var resp struct {
+		AccessPointsMap map[AccessPointType][]AccessPoint `json:"accessPointsMap"`
+	}
+	if err := s.shippingRequest(ctx, "shipping.getAccessPoints", http.MethodGet, "/accessPoints", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.AccessPointsMap, nil
+}
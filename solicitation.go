@@ -19,16 +19,15 @@ func (s *Client) CreateProductReviewAndSellerFeedbackSolicitation(ctx context.Co
 		RawQuery: query.Encode(),
 	}
 
-	req := request{
-		Method: http.MethodPost,
-		URL:    &u,
-		Token:  s.Token,
+	req := Request{
+		Operation:     "solicitations.createProductReviewAndSellerFeedbackSolicitation",
+		Method:        http.MethodPost,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 1 * time.Second,
 	}
 
-	_, err := s.retry(ctx, req, retryOptions{
-		retryLimit:    10,
-		sleepDuration: 1 * time.Second,
-	})
+	_, err := s.do(ctx, req)
 	if err != nil {
 		return err
 	}
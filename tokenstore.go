@@ -0,0 +1,38 @@
+package spapi
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenStore persists LWA tokens so several clients, or processes, can share
+// one seller's access token.
+type TokenStore interface {
+	Load(ctx context.Context) (*oauth2.Token, error)
+	Store(ctx context.Context, token *oauth2.Token) error
+}
+
+// MemoryTokenStore keeps a token in memory. It is safe for concurrent use.
+type MemoryTokenStore struct {
+	mu    sync.RWMutex
+	token *oauth2.Token
+}
+
+func NewMemoryTokenStore(token *oauth2.Token) *MemoryTokenStore {
+	return &MemoryTokenStore{token: token}
+}
+
+func (m *MemoryTokenStore) Load(ctx context.Context) (*oauth2.Token, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.token, nil
+}
+
+func (m *MemoryTokenStore) Store(ctx context.Context, token *oauth2.Token) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.token = token
+	return nil
+}
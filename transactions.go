@@ -0,0 +1,401 @@
+package spapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type TransactionStatus string
+
+var (
+	TransactionStatusDeferred         TransactionStatus = "DEFERRED"
+	TransactionStatusReleased         TransactionStatus = "RELEASED"
+	TransactionStatusDeferredReleased TransactionStatus = "DEFERRED_RELEASED"
+)
+
+type RelatedIdentifier struct {
+	RelatedIdentifierName  string `json:"relatedIdentifierName"` // ORDER_ID, SHIPMENT_ID, FINANCIAL_EVENT_GROUP_ID, REFUND_ID, ...
+	RelatedIdentifierValue string `json:"relatedIdentifierValue"`
+}
+
+type ItemRelatedIdentifier struct {
+	ItemRelatedIdentifierName  string `json:"itemRelatedIdentifierName"` // ORDER_ADJUSTMENT_ITEM_ID, COUPON_ID, REMOVAL_SHIPMENT_ITEM_ID, TRANSACTION_ID
+	ItemRelatedIdentifierValue string `json:"itemRelatedIdentifierValue"`
+}
+
+type SellingPartnerMetadata struct {
+	SellingPartnerID string `json:"sellingPartnerId"`
+	AccountType      string `json:"accountType"`
+	MarketplaceID    string `json:"marketplaceId"`
+}
+
+type MarketplaceDetails struct {
+	MarketplaceID   string `json:"marketplaceId"`
+	MarketplaceName string `json:"marketplaceName"`
+}
+
+// transactionsMoney is the {currencyCode, currencyAmount} form Finances
+// 2024-06-19 uses for amounts.
+type transactionsMoney struct {
+	CurrencyCode   string  `json:"currencyCode"`
+	CurrencyAmount Decimal `json:"currencyAmount"`
+}
+
+func (m *transactionsMoney) money() *Money {
+	if m == nil {
+		return nil
+	}
+	return &Money{CurrencyCode: m.CurrencyCode, Amount: m.CurrencyAmount}
+}
+
+// Breakdown is one node of a transaction's amount tree, e.g. Expenses >
+// AmazonFees > Commission. Leaf amounts add up to the parent's.
+type Breakdown struct {
+	BreakdownType   string      `json:"breakdownType"`
+	BreakdownAmount Money       `json:"breakdownAmount"`
+	Breakdowns      []Breakdown `json:"breakdowns"`
+}
+
+func (bd *Breakdown) UnmarshalJSON(b []byte) error {
+	type alias Breakdown
+	var v struct {
+		*alias
+		BreakdownAmount transactionsMoney `json:"breakdownAmount"`
+	}
+	v.alias = (*alias)(bd)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	bd.BreakdownAmount = *v.BreakdownAmount.money()
+	return nil
+}
+
+// TransactionContext flattens the polymorphic context types; only the fields
+// of ContextType are set.
+type TransactionContext struct {
+	ContextType string `json:"contextType"` // ProductContext, AmazonPayContext, PaymentsContext, DeferredContext, TimeRangeContext, BusinessContext
+
+	// ProductContext
+	ASIN               string `json:"asin,omitempty"`
+	SKU                string `json:"sku,omitempty"`
+	QuantityShipped    int    `json:"quantityShipped,omitempty"`
+	FulfillmentNetwork string `json:"fulfillmentNetwork,omitempty"` // MFN, AFN
+
+	// AmazonPayContext
+	StoreName        string     `json:"storeName,omitempty"`
+	OrderType        string     `json:"orderType,omitempty"`
+	Channel          string     `json:"channel,omitempty"`
+	PaymentType      string     `json:"paymentType,omitempty"`
+	PaymentMethod    string     `json:"paymentMethod,omitempty"`
+	PaymentReference string     `json:"paymentReference,omitempty"`
+	PaymentDate      *time.Time `json:"paymentDate,omitempty"`
+
+	// DeferredContext
+	DeferralReason string     `json:"deferralReason,omitempty"`
+	MaturityDate   *time.Time `json:"maturityDate,omitempty"`
+
+	// TimeRangeContext
+	StartTime *time.Time `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+}
+
+type TransactionItem struct {
+	Description        string                  `json:"description"`
+	RelatedIdentifiers []ItemRelatedIdentifier `json:"relatedIdentifiers"`
+	TotalAmount        Money                   `json:"totalAmount"`
+	Breakdowns         []Breakdown             `json:"breakdowns"`
+	Contexts           []TransactionContext    `json:"contexts"`
+}
+
+func (t *TransactionItem) UnmarshalJSON(b []byte) error {
+	type alias TransactionItem
+	var v struct {
+		*alias
+		TotalAmount transactionsMoney `json:"totalAmount"`
+	}
+	v.alias = (*alias)(t)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	t.TotalAmount = *v.TotalAmount.money()
+	return nil
+}
+
+type Transaction struct {
+	SellingPartnerMetadata SellingPartnerMetadata `json:"sellingPartnerMetadata"`
+	RelatedIdentifiers     []RelatedIdentifier    `json:"relatedIdentifiers"`
+	TransactionType        string                 `json:"transactionType"`
+	TransactionID          string                 `json:"transactionId"`
+	TransactionStatus      TransactionStatus      `json:"transactionStatus"`
+	Description            string                 `json:"description"`
+	PostedDate             time.Time              `json:"postedDate"`
+	TotalAmount            Money                  `json:"totalAmount"`
+	MarketplaceDetails     MarketplaceDetails     `json:"marketplaceDetails"`
+	Items                  []TransactionItem      `json:"items"`
+	Contexts               []TransactionContext   `json:"contexts"`
+	Breakdowns             []Breakdown            `json:"breakdowns"`
+}
+
+func (t *Transaction) UnmarshalJSON(b []byte) error {
+	type alias Transaction
+	var v struct {
+		*alias
+		TotalAmount transactionsMoney `json:"totalAmount"`
+	}
+	v.alias = (*alias)(t)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	t.TotalAmount = *v.TotalAmount.money()
+	return nil
+}
+
+// RelatedIdentifier returns the value of the named identifier, e.g.
+// "ORDER_ID", or "" if it is absent.
+func (t *Transaction) RelatedIdentifier(name string) string {
+	for _, id := range t.RelatedIdentifiers {
+		if id.RelatedIdentifierName == name {
+			return id.RelatedIdentifierValue
+		}
+	}
+	return ""
+}
+
+func (t *Transaction) OrderID() string {
+	return t.RelatedIdentifier("ORDER_ID")
+}
+
+// CostLine is one leaf of a breakdown tree. Type is the path from the root,
+// e.g. "Expenses/AmazonFees/Commission".
+type CostLine struct {
+	TransactionID   string
+	TransactionType string
+	PostedDate      time.Time
+	OrderID         string
+	SKU             string
+	ASIN            string
+	Type            string
+	Amount          Money
+}
+
+func productContext(contexts []TransactionContext) (sku, asin string) {
+	for _, c := range contexts {
+		if c.ContextType == "ProductContext" {
+			return c.SKU, c.ASIN
+		}
+	}
+	return "", ""
+}
+
+func flattenBreakdowns(prefix string, bs []Breakdown, fn func(typ string, amount Money)) {
+	for _, b := range bs {
+		typ := b.BreakdownType
+		if prefix != "" {
+			typ = prefix + "/" + typ
+		}
+		if len(b.Breakdowns) == 0 {
+			fn(typ, b.BreakdownAmount)
+			continue
+		}
+		flattenBreakdowns(typ, b.Breakdowns, fn)
+	}
+}
+
+// CostLines flattens the breakdown trees into leaf lines. Item breakdowns
+// are used when present so amounts are attributed per SKU; otherwise the
+// transaction-level breakdowns are used. Parent nodes are skipped. When item
+// lines do not add up to the transaction total, the difference is emitted as
+// an "Unattributed" line without SKU, so the lines sum to the total.
+func (t *Transaction) CostLines() []CostLine {
+	orderID := t.OrderID()
+	sku, asin := productContext(t.Contexts)
+
+	var lines []CostLine
+	add := func(sku, asin string) func(string, Money) {
+		return func(typ string, amount Money) {
+			lines = append(lines, CostLine{
+				TransactionID:   t.TransactionID,
+				TransactionType: t.TransactionType,
+				PostedDate:      t.PostedDate,
+				OrderID:         orderID,
+				SKU:             sku,
+				ASIN:            asin,
+				Type:            typ,
+				Amount:          amount,
+			})
+		}
+	}
+
+	itemized := false
+	for _, item := range t.Items {
+		if len(item.Breakdowns) == 0 {
+			continue
+		}
+		itemized = true
+		itemSKU, itemASIN := productContext(item.Contexts)
+		if itemSKU == "" && itemASIN == "" {
+			itemSKU, itemASIN = sku, asin
+		}
+		flattenBreakdowns("", item.Breakdowns, add(itemSKU, itemASIN))
+	}
+	if !itemized {
+		flattenBreakdowns("", t.Breakdowns, add(sku, asin))
+		return lines
+	}
+
+	rest := t.TotalAmount
+	for _, l := range lines {
+		var err error
+		if rest, err = rest.Sub(l.Amount); err != nil {
+			// mixed currencies cannot be reconciled
+			return lines
+		}
+	}
+	if !rest.IsZero() {
+		add("", "")("Unattributed", rest)
+	}
+	return lines
+}
+
+// CostLinesByOrder groups the cost lines of ts by order ID. Lines without an
+// order are keyed by "".
+func CostLinesByOrder(ts []Transaction) map[string][]CostLine {
+	out := map[string][]CostLine{}
+	for i := range ts {
+		for _, l := range ts[i].CostLines() {
+			out[l.OrderID] = append(out[l.OrderID], l)
+		}
+	}
+	return out
+}
+
+// CostLinesBySKU groups the cost lines of ts by SKU. Lines without a SKU are
+// keyed by "".
+func CostLinesBySKU(ts []Transaction) map[string][]CostLine {
+	out := map[string][]CostLine{}
+	for i := range ts {
+		for _, l := range ts[i].CostLines() {
+			out[l.SKU] = append(out[l.SKU], l)
+		}
+	}
+	return out
+}
+
+// ListTransactionsRequest filters transactions. PostedAfter is required by
+// the API; a nil request lists the last 24 hours.
+type ListTransactionsRequest struct {
+	PostedAfter       time.Time
+	PostedBefore      time.Time
+	MarketplaceID     string
+	TransactionStatus TransactionStatus
+}
+
+// ErrStopPagination can be returned from an EachTransaction callback to stop
+// early without an error.
+var ErrStopPagination = errors.New("spapi: stop pagination")
+
+func (s *Client) listTransactions(ctx context.Context, qs url.Values) ([]Transaction, string, error) {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     s.Marketplace.Endpoint,
+		Path:     "/finances/2024-06-19/transactions",
+		RawQuery: qs.Encode(),
+	}
+
+	req := Request{
+		Operation:     "finances.listTransactions",
+		Method:        http.MethodGet,
+		URL:           &u,
+		RetryLimit:    10,
+		SleepDuration: 2 * time.Second,
+	}
+
+	res, err := s.do(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	var resp struct {
+		Payload struct {
+			NextToken    string        `json:"nextToken"`
+			Transactions []Transaction `json:"transactions"`
+		} `json:"payload"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, "", fmt.Errorf("error decoding spapi response: %w", err)
+	}
+	return resp.Payload.Transactions, resp.Payload.NextToken, nil
+}
+
+// EachTransaction streams transactions page by page, calling fn for each one
+// without holding the full result in memory. Returning ErrStopPagination
+// from fn stops early.
+func (s *Client) EachTransaction(ctx context.Context, opts *ListTransactionsRequest, fn func(*Transaction) error) error {
+	if opts == nil {
+		opts = &ListTransactionsRequest{
+			PostedAfter: time.Now().Add(-24 * time.Hour),
+		}
+	}
+	if opts.PostedAfter.IsZero() {
+		return fmt.Errorf("PostedAfter is required")
+	}
+
+	qs := url.Values{}
+	qs.Set("postedAfter", opts.PostedAfter.Format(time.RFC3339))
+	if !opts.PostedBefore.IsZero() {
+		qs.Set("postedBefore", opts.PostedBefore.Format(time.RFC3339))
+	}
+	if opts.MarketplaceID != "" {
+		qs.Set("marketplaceId", opts.MarketplaceID)
+	}
+	if opts.TransactionStatus != "" {
+		qs.Set("transactionStatus", strings.ToUpper(string(opts.TransactionStatus)))
+	}
+
+	for page := 1; ; page++ {
+		ts, next, err := s.listTransactions(ctx, qs)
+		if err != nil {
+			return err
+		}
+		s.logger().LogAttrs(ctx, slog.LevelDebug, "fetched transactions page",
+			slog.Int("page", page),
+			slog.Int("transactions", len(ts)),
+		)
+
+		for i := range ts {
+			if err := fn(&ts[i]); err != nil {
+				if errors.Is(err, ErrStopPagination) {
+					return nil
+				}
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		qs.Set("nextToken", next)
+	}
+}
+
+// ListTransactions returns every matching transaction, including deferred
+// ones. Use EachTransaction for large date ranges.
+func (s *Client) ListTransactions(ctx context.Context, opts *ListTransactionsRequest) ([]Transaction, error) {
+	var ts []Transaction
+	err := s.EachTransaction(ctx, opts, func(t *Transaction) error {
+		ts = append(ts, *t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ts, nil
+}